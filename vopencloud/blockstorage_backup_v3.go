@@ -0,0 +1,100 @@
+package vopencloud
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/backups"
+)
+
+// blockStorageBackupV3ListOpts allows to filter the detailed list of backups.
+// The upstream backups.ListDetailOpts lacks the filter fields.
+type blockStorageBackupV3ListOpts struct {
+	Name     string `q:"name"`
+	Status   string `q:"status"`
+	VolumeID string `q:"volume_id"`
+}
+
+func (opts blockStorageBackupV3ListOpts) ToBackupListDetailQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// blockStorageV3BackupSort represents a sortable slice of block storage
+// v3 backups.
+type blockStorageV3BackupSort []backups.Backup
+
+func (backup blockStorageV3BackupSort) Len() int {
+	return len(backup)
+}
+
+func (backup blockStorageV3BackupSort) Swap(i, j int) {
+	backup[i], backup[j] = backup[j], backup[i]
+}
+
+func (backup blockStorageV3BackupSort) Less(i, j int) bool {
+	itime := backup[i].CreatedAt
+	jtime := backup[j].CreatedAt
+	return itime.Unix() < jtime.Unix()
+}
+
+func dataSourceBlockStorageV3MostRecentBackup(allBackups []backups.Backup) backups.Backup {
+	sortedBackups := make([]backups.Backup, len(allBackups))
+	copy(sortedBackups, allBackups)
+	sort.Sort(blockStorageV3BackupSort(sortedBackups))
+	return sortedBackups[len(sortedBackups)-1]
+}
+
+func blockStorageBackupV3StateRefreshFunc(client *gophercloud.ServiceClient, backupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		b, err := backups.Get(client, backupID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return b, "deleted", nil
+			}
+
+			return nil, "", err
+		}
+
+		if b.Status == "error" || b.Status == "error_deleting" {
+			return b, b.Status, fmt.Errorf("The backup is in %s status: %s", b.Status, b.FailReason)
+		}
+
+		return b, b.Status, nil
+	}
+}
+
+// blockStorageBackupV3MetadataMicroversion is the minimal microversion,
+// which returns both the metadata and the availability zone of a backup.
+const blockStorageBackupV3MetadataMicroversion = "3.51"
+
+// blockStorageBackupV3GetMicroversion returns the microversion used to read
+// a backup: the microversion, which returns both the metadata and the
+// availability zone, capped at the maximum microversion of the API.
+func blockStorageBackupV3GetMicroversion(maxMicroversion string) (string, error) {
+	ok, err := compatibleMicroversion("min", blockStorageBackupV3MetadataMicroversion, maxMicroversion)
+	if err != nil {
+		return "", err
+	}
+	if ok {
+		return blockStorageBackupV3MetadataMicroversion, nil
+	}
+
+	return maxMicroversion, nil
+}
+
+// blockStorageBackupV3CreateMicroversion returns the minimal Block Storage
+// API microversion, which is required to pass the backup create options.
+func blockStorageBackupV3CreateMicroversion(opts backups.CreateOpts) string {
+	switch {
+	case opts.AvailabilityZone != "":
+		return "3.51"
+	case len(opts.Metadata) > 0:
+		return "3.43"
+	}
+
+	return ""
+}
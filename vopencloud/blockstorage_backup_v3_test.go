@@ -0,0 +1,72 @@
+package vopencloud
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/backups"
+)
+
+func TestUnitBlockStorageV3MostRecentBackup(t *testing.T) {
+	allBackups := []backups.Backup{
+		{
+			ID:        "7f6e5a1c-1a47-4d4e-a3d9-5c4cd7c3e1a2",
+			CreatedAt: time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        "0c1b3a8e-95b0-4b7f-8e2f-59f2c4d3a9b1",
+			CreatedAt: time.Date(2023, 5, 3, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        "b9a2e4f0-6c1d-4b8a-9d7e-3f5a2c1b0e9d",
+			CreatedAt: time.Date(2023, 5, 2, 10, 0, 0, 0, time.UTC),
+		},
+	}
+
+	actual := dataSourceBlockStorageV3MostRecentBackup(allBackups)
+	assert.Equal(t, "0c1b3a8e-95b0-4b7f-8e2f-59f2c4d3a9b1", actual.ID)
+	assert.Equal(t, "7f6e5a1c-1a47-4d4e-a3d9-5c4cd7c3e1a2", allBackups[0].ID)
+	assert.Equal(t, "b9a2e4f0-6c1d-4b8a-9d7e-3f5a2c1b0e9d", allBackups[2].ID)
+}
+
+func TestUnitBlockStorageBackupV3CreateMicroversion(t *testing.T) {
+	assert.Equal(t, "", blockStorageBackupV3CreateMicroversion(backups.CreateOpts{
+		VolumeID: "v1",
+	}))
+
+	assert.Equal(t, "3.43", blockStorageBackupV3CreateMicroversion(backups.CreateOpts{
+		VolumeID: "v1",
+		Metadata: map[string]string{"foo": "bar"},
+	}))
+
+	assert.Equal(t, "3.51", blockStorageBackupV3CreateMicroversion(backups.CreateOpts{
+		VolumeID:         "v1",
+		Metadata:         map[string]string{"foo": "bar"},
+		AvailabilityZone: "nova",
+	}))
+}
+
+func TestUnitBlockStorageBackupV3ListOpts(t *testing.T) {
+	q, err := blockStorageBackupV3ListOpts{
+		Name:     "backup_1",
+		VolumeID: "v1",
+	}.ToBackupListDetailQuery()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "?name=backup_1&volume_id=v1", q)
+}
+
+func TestUnitBlockStorageBackupV3GetMicroversion(t *testing.T) {
+	actual, err := blockStorageBackupV3GetMicroversion("3.70")
+	assert.NoError(t, err)
+	assert.Equal(t, "3.51", actual)
+
+	actual, err = blockStorageBackupV3GetMicroversion("3.45")
+	assert.NoError(t, err)
+	assert.Equal(t, "3.45", actual)
+
+	_, err = blockStorageBackupV3GetMicroversion("3")
+	assert.Error(t, err)
+}
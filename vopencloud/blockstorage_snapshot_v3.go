@@ -1,8 +1,12 @@
 package vopencloud
 
 import (
+	"fmt"
 	"sort"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/snapshots"
 )
 
@@ -29,3 +33,39 @@ func dataSourceBlockStorageV3MostRecentSnapshot(snapshots []snapshots.Snapshot)
 	sort.Sort(blockStorageV3SnapshotSort(sortedSnapshots))
 	return sortedSnapshots[len(sortedSnapshots)-1]
 }
+
+func blockStorageSnapshotV3StateRefreshFunc(client *gophercloud.ServiceClient, snapshotID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := snapshots.Get(client, snapshotID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return v, "deleted", nil
+			}
+
+			return nil, "", err
+		}
+
+		if v.Status == "error" || v.Status == "error_deleting" {
+			return v, v.Status, fmt.Errorf("The snapshot is in %s status. "+
+				"Please check with your cloud admin or check the Block Storage "+
+				"API logs to see why this error occurred.", v.Status)
+		}
+
+		return v, v.Status, nil
+	}
+}
+
+// blockStorageSnapshotV3ResetMetadata replaces the metadata of a snapshot.
+// Unlike snapshots.UpdateMetadata, which merges the keys, the removed keys
+// are deleted.
+func blockStorageSnapshotV3ResetMetadata(client *gophercloud.ServiceClient, id string, metadata map[string]string) error {
+	b := map[string]interface{}{
+		"metadata": metadata,
+	}
+
+	_, err := client.Put(client.ServiceURL("snapshots", id, "metadata"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
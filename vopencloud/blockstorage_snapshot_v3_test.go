@@ -0,0 +1,64 @@
+package vopencloud
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestUnitBlockStorageSnapshotV3StateRefreshFunc(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	status := "available"
+	th.Mux.HandleFunc("/snapshots/d32019d3-bc6e-4319-9c1d-6722fc136a22", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", thclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		if status == "" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"snapshot": {"id": "d32019d3-bc6e-4319-9c1d-6722fc136a22", "status": "%s"}}`, status)
+	})
+
+	refresh := blockStorageSnapshotV3StateRefreshFunc(thclient.ServiceClient(), "d32019d3-bc6e-4319-9c1d-6722fc136a22")
+
+	_, actual, err := refresh()
+	assert.NoError(t, err)
+	assert.Equal(t, "available", actual)
+
+	status = "error"
+	_, actual, err = refresh()
+	assert.Error(t, err)
+	assert.Equal(t, "error", actual)
+
+	status = ""
+	_, actual, err = refresh()
+	assert.NoError(t, err)
+	assert.Equal(t, "deleted", actual)
+}
+
+func TestUnitBlockStorageSnapshotV3ResetMetadata(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/snapshots/d32019d3-bc6e-4319-9c1d-6722fc136a22/metadata", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, `{"metadata": {}}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"metadata": {}}`)
+	})
+
+	err := blockStorageSnapshotV3ResetMetadata(thclient.ServiceClient(), "d32019d3-bc6e-4319-9c1d-6722fc136a22", map[string]string{})
+	assert.NoError(t, err)
+}
@@ -7,10 +7,33 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/apiversions"
 	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
 	"github.com/gophercloud/utils/terraform/hashcode"
 )
 
+const (
+	// blockStorageV3OnlineResizeMicroversion is the minimal microversion,
+	// which allows to extend an attached volume.
+	blockStorageV3OnlineResizeMicroversion = "3.42"
+)
+
+// blockStorageV3MaxMicroversion returns the maximum microversion supported
+// by the Block Storage v3 API.
+func blockStorageV3MaxMicroversion(client *gophercloud.ServiceClient) (string, error) {
+	allPages, err := apiversions.List(client).AllPages()
+	if err != nil {
+		return "", err
+	}
+
+	v, err := apiversions.ExtractAPIVersion(allPages, "v3.0")
+	if err != nil {
+		return "", err
+	}
+
+	return v.Version, nil
+}
+
 func flattenBlockStorageVolumeV3Attachments(v []volumes.Attachment) []map[string]interface{} {
 	attachments := make([]map[string]interface{}, len(v))
 	for i, attachment := range v {
@@ -1,12 +1,16 @@
 package vopencloud
 
 import (
+	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
 )
 
 func blockStorageVolumeV3VolumeFixture() volumes.Volume {
@@ -61,3 +65,36 @@ func TestUnitBlockStorageVolumeV3AttachmentHash(t *testing.T) {
 
 	assert.Equal(t, expectedHashcode, actualHashcode)
 }
+
+func TestUnitBlockStorageV3MaxMicroversion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `
+{
+  "versions": [
+    {
+      "id": "v3.0",
+      "min_version": "3.0",
+      "status": "CURRENT",
+      "updated": "2023-06-01T00:00:00Z",
+      "version": "3.70"
+    }
+  ]
+}
+`)
+	})
+
+	actual, err := blockStorageV3MaxMicroversion(thclient.ServiceClient())
+	assert.NoError(t, err)
+	assert.Equal(t, "3.70", actual)
+
+	ok, err := compatibleMicroversion("min", blockStorageV3OnlineResizeMicroversion, actual)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
@@ -1,9 +1,45 @@
 package vopencloud
 
-import "github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+import (
+	"context"
+	"log"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+)
 
 const computeV2FlavorDescriptionMicroversion = "2.55"
 
+// computeFlavorV2UpdateOpts represents the attributes used when updating an
+// existing flavor. Unlike flavors.UpdateOpts, an empty description is sent
+// as null to clear it.
+type computeFlavorV2UpdateOpts struct {
+	Description *string `json:"description"`
+}
+
+// ToFlavorUpdateMap casts a computeFlavorV2UpdateOpts struct to a map.
+func (opts computeFlavorV2UpdateOpts) ToFlavorUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "flavor")
+}
+
+// computeFlavorsV2Filter represents the client side filters of the
+// openstack_compute_flavors_v2 data source. Zero values are ignored.
+type computeFlavorsV2Filter struct {
+	NameRegex     *regexp.Regexp
+	MinVCPUs      int
+	MaxVCPUs      int
+	MinRAM        int
+	MaxRAM        int
+	MaxDisk       int
+	ExtraSpecs    map[string]string
+	ExtraSpecKeys []string
+}
+
 func expandComputeFlavorV2ExtraSpecs(raw map[string]interface{}) flavors.ExtraSpecsOpts {
 	extraSpecs := make(flavors.ExtraSpecsOpts, len(raw))
 	for k, v := range raw {
@@ -12,3 +48,133 @@ func expandComputeFlavorV2ExtraSpecs(raw map[string]interface{}) flavors.ExtraSp
 
 	return extraSpecs
 }
+
+// computeFlavorV2ExtraSpecsChanges returns the extra specs to create or
+// update and the sorted keys of the extra specs to delete.
+func computeFlavorV2ExtraSpecsChanges(oldRaw, newRaw map[string]interface{}) (flavors.ExtraSpecsOpts, []string) {
+	extraSpecs := make(flavors.ExtraSpecsOpts)
+	for k, v := range newRaw {
+		if oldValue, ok := oldRaw[k]; !ok || oldValue != v {
+			extraSpecs[k] = v.(string)
+		}
+	}
+
+	var deleteKeys []string
+	for k := range oldRaw {
+		if _, ok := newRaw[k]; !ok {
+			deleteKeys = append(deleteKeys, k)
+		}
+	}
+	sort.Strings(deleteKeys)
+
+	return extraSpecs, deleteKeys
+}
+
+// computeFlavorV2UpdateExtraSpecs applies the extra specs changes without
+// touching the unchanged extra specs.
+func computeFlavorV2UpdateExtraSpecs(ctx context.Context, client *gophercloud.ServiceClient, id string, oldRaw, newRaw map[string]interface{}, timeout time.Duration) error {
+	extraSpecs, deleteKeys := computeFlavorV2ExtraSpecsChanges(oldRaw, newRaw)
+
+	for _, key := range deleteKeys {
+		log.Printf("[DEBUG] Deleting extra_spec %s from openstack_compute_flavor_v2 %s", key, id)
+		err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+			err := flavors.DeleteExtraSpec(client, id, key).ExtractErr()
+			if err != nil {
+				if _, ok := err.(gophercloud.ErrDefault404); ok {
+					return nil
+				}
+				return checkForRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(extraSpecs) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Setting extra_specs of openstack_compute_flavor_v2 %s: %#v", id, extraSpecs)
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		_, err := flavors.CreateExtraSpecs(client, id, extraSpecs).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+}
+
+// computeFlavorsV2FilterBySize returns the flavors, which match the name and
+// size filters.
+func computeFlavorsV2FilterBySize(allFlavors []flavors.Flavor, filter computeFlavorsV2Filter) []flavors.Flavor {
+	var result []flavors.Flavor
+	for _, flavor := range allFlavors {
+		if filter.NameRegex != nil && !filter.NameRegex.MatchString(flavor.Name) {
+			continue
+		}
+
+		if filter.MinVCPUs > 0 && flavor.VCPUs < filter.MinVCPUs {
+			continue
+		}
+
+		if filter.MaxVCPUs > 0 && flavor.VCPUs > filter.MaxVCPUs {
+			continue
+		}
+
+		if filter.MinRAM > 0 && flavor.RAM < filter.MinRAM {
+			continue
+		}
+
+		if filter.MaxRAM > 0 && flavor.RAM > filter.MaxRAM {
+			continue
+		}
+
+		if filter.MaxDisk > 0 && flavor.Disk > filter.MaxDisk {
+			continue
+		}
+
+		result = append(result, flavor)
+	}
+
+	return result
+}
+
+// computeFlavorV2MatchExtraSpecs returns true, when the flavor extra specs
+// contain all the filter extra specs and keys.
+func computeFlavorV2MatchExtraSpecs(extraSpecs map[string]string, filter computeFlavorsV2Filter) bool {
+	for k, v := range filter.ExtraSpecs {
+		if extraSpecs[k] != v {
+			return false
+		}
+	}
+
+	for _, k := range filter.ExtraSpecKeys {
+		if _, ok := extraSpecs[k]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenComputeFlavorsV2(allFlavors []flavors.Flavor) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(allFlavors))
+	for i, flavor := range allFlavors {
+		result[i] = map[string]interface{}{
+			"id":           flavor.ID,
+			"name":         flavor.Name,
+			"description":  flavor.Description,
+			"vcpus":        flavor.VCPUs,
+			"ram":          flavor.RAM,
+			"disk":         flavor.Disk,
+			"swap":         flavor.Swap,
+			"ephemeral":    flavor.Ephemeral,
+			"rx_tx_factor": flavor.RxTxFactor,
+			"is_public":    flavor.IsPublic,
+		}
+	}
+
+	return result
+}
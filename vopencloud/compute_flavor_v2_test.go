@@ -2,6 +2,7 @@ package vopencloud
 
 import (
 	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
@@ -24,3 +25,132 @@ func TestUnitExpandComputeFlavorV2ExtraSpecs(t *testing.T) {
 		t.Fatalf("Results differ. Want: %#v, but got %#v", expected, actual)
 	}
 }
+
+func TestUnitComputeFlavorV2ExtraSpecsChanges(t *testing.T) {
+	oldRaw := map[string]interface{}{
+		"foo": "bar",
+		"bar": "baz",
+		"baz": "foo",
+		"qux": "quux",
+	}
+
+	newRaw := map[string]interface{}{
+		"foo":  "bar",
+		"bar":  "qux",
+		"quux": "corge",
+	}
+
+	expectedExtraSpecs := flavors.ExtraSpecsOpts{
+		"bar":  "qux",
+		"quux": "corge",
+	}
+	expectedDeleteKeys := []string{"baz", "qux"}
+
+	actualExtraSpecs, actualDeleteKeys := computeFlavorV2ExtraSpecsChanges(oldRaw, newRaw)
+
+	if !reflect.DeepEqual(expectedExtraSpecs, actualExtraSpecs) {
+		t.Fatalf("Results differ. Want: %#v, but got %#v", expectedExtraSpecs, actualExtraSpecs)
+	}
+
+	if !reflect.DeepEqual(expectedDeleteKeys, actualDeleteKeys) {
+		t.Fatalf("Results differ. Want: %#v, but got %#v", expectedDeleteKeys, actualDeleteKeys)
+	}
+}
+
+func TestUnitComputeFlavorV2UpdateOpts(t *testing.T) {
+	description := "foo"
+
+	expected := map[string]interface{}{
+		"flavor": map[string]interface{}{
+			"description": "foo",
+		},
+	}
+
+	actual, err := computeFlavorV2UpdateOpts{Description: &description}.ToFlavorUpdateMap()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Results differ. Want: %#v, but got %#v", expected, actual)
+	}
+
+	expected = map[string]interface{}{
+		"flavor": map[string]interface{}{
+			"description": nil,
+		},
+	}
+
+	actual, err = computeFlavorV2UpdateOpts{}.ToFlavorUpdateMap()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Results differ. Want: %#v, but got %#v", expected, actual)
+	}
+}
+
+func TestUnitComputeFlavorsV2FilterBySize(t *testing.T) {
+	allFlavors := []flavors.Flavor{
+		{ID: "1", Name: "m1.tiny", VCPUs: 1, RAM: 512, Disk: 1},
+		{ID: "2", Name: "m1.small", VCPUs: 1, RAM: 2048, Disk: 20},
+		{ID: "3", Name: "m1.medium", VCPUs: 2, RAM: 4096, Disk: 40},
+		{ID: "4", Name: "c1.large", VCPUs: 4, RAM: 8192, Disk: 80},
+	}
+
+	filter := computeFlavorsV2Filter{
+		NameRegex: regexp.MustCompile("^m1"),
+		MaxVCPUs:  2,
+		MinRAM:    1024,
+	}
+
+	expected := []flavors.Flavor{allFlavors[1], allFlavors[2]}
+	actual := computeFlavorsV2FilterBySize(allFlavors, filter)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Results differ. Want: %#v, but got %#v", expected, actual)
+	}
+
+	filter = computeFlavorsV2Filter{
+		MinVCPUs: 2,
+		MaxRAM:   8192,
+		MaxDisk:  40,
+	}
+
+	expected = []flavors.Flavor{allFlavors[2]}
+	actual = computeFlavorsV2FilterBySize(allFlavors, filter)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Results differ. Want: %#v, but got %#v", expected, actual)
+	}
+}
+
+func TestUnitComputeFlavorV2MatchExtraSpecs(t *testing.T) {
+	extraSpecs := map[string]string{
+		"hw:cpu_policy":        "dedicated",
+		"hw:cpu_thread_policy": "prefer",
+	}
+
+	filter := computeFlavorsV2Filter{
+		ExtraSpecs:    map[string]string{"hw:cpu_policy": "dedicated"},
+		ExtraSpecKeys: []string{"hw:cpu_thread_policy"},
+	}
+	if !computeFlavorV2MatchExtraSpecs(extraSpecs, filter) {
+		t.Fatalf("Extra specs %#v should match %#v", extraSpecs, filter)
+	}
+
+	filter = computeFlavorsV2Filter{
+		ExtraSpecs: map[string]string{"hw:cpu_policy": "shared"},
+	}
+	if computeFlavorV2MatchExtraSpecs(extraSpecs, filter) {
+		t.Fatalf("Extra specs %#v should not match %#v", extraSpecs, filter)
+	}
+
+	filter = computeFlavorsV2Filter{
+		ExtraSpecKeys: []string{"hw:mem_page_size"},
+	}
+	if computeFlavorV2MatchExtraSpecs(extraSpecs, filter) {
+		t.Fatalf("Extra specs %#v should not match %#v", extraSpecs, filter)
+	}
+}
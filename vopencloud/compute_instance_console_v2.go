@@ -0,0 +1,52 @@
+package vopencloud
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	computeV2InstanceRemoteConsoleMicroversion    = "2.6"
+	computeV2InstanceRemoteConsoleMKSMicroversion = "2.8"
+)
+
+// computeV2InstanceConsoleTypes maps the remote console protocols to the
+// supported console types. The first type is the default one.
+var computeV2InstanceConsoleTypes = map[string][]string{
+	"vnc":    {"novnc", "xvpvnc"},
+	"spice":  {"spice-html5"},
+	"serial": {"serial"},
+	"rdp":    {"rdp-html5"},
+	"mks":    {"webmks"},
+}
+
+// expandComputeV2InstanceConsoleType returns the remote console type to use
+// for the protocol. If the type isn't set, the default type of the protocol
+// is returned.
+func expandComputeV2InstanceConsoleType(protocol, consoleType string) (string, error) {
+	types, ok := computeV2InstanceConsoleTypes[protocol]
+	if !ok {
+		return "", fmt.Errorf("Unsupported console protocol: %s", protocol)
+	}
+
+	if consoleType == "" {
+		return types[0], nil
+	}
+
+	if !strSliceContains(types, consoleType) {
+		return "", fmt.Errorf("Console type %s isn't supported by %s protocol, must be one of: %s",
+			consoleType, protocol, strings.Join(types, ", "))
+	}
+
+	return consoleType, nil
+}
+
+// computeV2InstanceConsoleMicroversion returns the Compute API microversion
+// required to create a remote console of the protocol.
+func computeV2InstanceConsoleMicroversion(protocol string) string {
+	if protocol == "mks" {
+		return computeV2InstanceRemoteConsoleMKSMicroversion
+	}
+
+	return computeV2InstanceRemoteConsoleMicroversion
+}
@@ -0,0 +1,33 @@
+package vopencloud
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitExpandComputeV2InstanceConsoleType(t *testing.T) {
+	consoleType, err := expandComputeV2InstanceConsoleType("vnc", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "novnc", consoleType)
+
+	consoleType, err = expandComputeV2InstanceConsoleType("vnc", "xvpvnc")
+	assert.NoError(t, err)
+	assert.Equal(t, "xvpvnc", consoleType)
+
+	consoleType, err = expandComputeV2InstanceConsoleType("serial", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "serial", consoleType)
+
+	_, err = expandComputeV2InstanceConsoleType("spice", "novnc")
+	assert.EqualError(t, err, "Console type novnc isn't supported by spice protocol, must be one of: spice-html5")
+
+	_, err = expandComputeV2InstanceConsoleType("telnet", "")
+	assert.EqualError(t, err, "Unsupported console protocol: telnet")
+}
+
+func TestUnitComputeV2InstanceConsoleMicroversion(t *testing.T) {
+	assert.Equal(t, "2.6", computeV2InstanceConsoleMicroversion("vnc"))
+	assert.Equal(t, "2.6", computeV2InstanceConsoleMicroversion("rdp"))
+	assert.Equal(t, "2.8", computeV2InstanceConsoleMicroversion("mks"))
+}
@@ -0,0 +1,16 @@
+package vopencloud
+
+// flattenComputeInstanceSnapshotV2Metadata returns the snapshot image
+// properties, which are tracked in the metadata argument. The compute and
+// image services add their own properties to the snapshot, so that the
+// other properties are ignored.
+func flattenComputeInstanceSnapshotV2Metadata(properties map[string]interface{}, metadata map[string]interface{}) map[string]string {
+	res := make(map[string]string, len(metadata))
+	for key := range metadata {
+		if v, ok := properties[key].(string); ok {
+			res[key] = v
+		}
+	}
+
+	return res
+}
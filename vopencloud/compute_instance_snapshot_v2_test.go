@@ -0,0 +1,30 @@
+package vopencloud
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitFlattenComputeInstanceSnapshotV2Metadata(t *testing.T) {
+	properties := map[string]interface{}{
+		"instance_uuid": "3a2f7e1a-6d6e-4b5c-8c0c-36ed1e0a0c7a",
+		"image_type":    "snapshot",
+		"purpose":       "nightly",
+		"owner":         "ops",
+	}
+
+	metadata := map[string]interface{}{
+		"purpose": "backup",
+		"owner":   "ops",
+		"removed": "value",
+	}
+
+	expected := map[string]string{
+		"purpose": "nightly",
+		"owner":   "ops",
+	}
+
+	assert.Equal(t, expected, flattenComputeInstanceSnapshotV2Metadata(properties, metadata))
+	assert.Empty(t, flattenComputeInstanceSnapshotV2Metadata(properties, nil))
+}
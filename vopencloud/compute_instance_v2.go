@@ -560,10 +560,10 @@ func computeV2InstanceReadTags(d *schema.ResourceData, tags []string) {
 	expandObjectReadTags(d, tags)
 }
 
-func computeV2InstanceUpdateTags(d *schema.ResourceData) []string {
-	return expandObjectUpdateTags(d)
+func computeV2InstanceUpdateTags(d *schema.ResourceData, defaultTags []string) []string {
+	return expandObjectUpdateTags(d, defaultTags)
 }
 
-func computeV2InstanceTags(d *schema.ResourceData) []string {
-	return expandObjectTags(d)
+func computeV2InstanceTags(d *schema.ResourceData, defaultTags []string) []string {
+	return expandObjectCreateTags(d, defaultTags)
 }
@@ -0,0 +1,287 @@
+package vopencloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/extendedserverattributes"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/extendedstatus"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+)
+
+const (
+	computeV2InstanceCreateServerWithHostMicroversion = "2.74"
+	computeV2InstanceLiveMigrateMicroversion          = "2.25"
+	computeV2InstanceMigrateHostMicroversion          = "2.56"
+	computeV2InstanceEvacuateMicroversion             = "2.29"
+)
+
+// computeV2InstanceCreateOptsExt adds the target compute host to the server
+// create options.
+type computeV2InstanceCreateOptsExt struct {
+	servers.CreateOptsBuilder
+
+	Host string
+}
+
+// ToServerCreateMap adds the host to the base server creation options.
+func (opts computeV2InstanceCreateOptsExt) ToServerCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToServerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Host != "" {
+		serverMap := base["server"].(map[string]interface{})
+		serverMap["host"] = opts.Host
+	}
+
+	return base, nil
+}
+
+// computeV2InstanceMigration represents the way an instance is moved to
+// another compute host.
+type computeV2InstanceMigration struct {
+	Type               string
+	Host               string
+	BlockMigration     string
+	IgnoreConfirmation bool
+}
+
+// computeV2InstanceLiveMigrateOpts represents the live migration options.
+// Unlike migrate.LiveMigrateOpts, block_migration accepts the "auto" value.
+type computeV2InstanceLiveMigrateOpts struct {
+	Host           *string     `json:"host"`
+	BlockMigration interface{} `json:"block_migration"`
+}
+
+// computeV2InstanceMigrateOpts represents the cold migration options.
+type computeV2InstanceMigrateOpts struct {
+	Host string `json:"host,omitempty"`
+}
+
+// computeV2InstanceEvacuateOpts represents the evacuation options. Unlike
+// evacuate.EvacuateOpts, onSharedStorage isn't sent, since it was removed
+// from the API.
+type computeV2InstanceEvacuateOpts struct {
+	Host string `json:"host,omitempty"`
+}
+
+// computeV2InstanceServerWithHost represents a server with its current task
+// state and compute host.
+type computeV2InstanceServerWithHost struct {
+	servers.Server
+	extendedstatus.ServerExtendedStatusExt
+	extendedserverattributes.ServerAttributesExt
+}
+
+func expandComputeV2InstanceMigration(d *schema.ResourceData) computeV2InstanceMigration {
+	migration := computeV2InstanceMigration{
+		Type:           "live",
+		Host:           d.Get("host").(string),
+		BlockMigration: "auto",
+	}
+
+	for _, v := range d.Get("migration").([]interface{}) {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		migration.Type = m["type"].(string)
+		migration.BlockMigration = m["block_migration"].(string)
+		migration.IgnoreConfirmation = m["ignore_confirmation"].(bool)
+	}
+
+	return migration
+}
+
+func expandComputeV2InstanceBlockMigration(v string) interface{} {
+	switch v {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+
+	return "auto"
+}
+
+func computeV2InstanceAction(client *gophercloud.ServiceClient, id string, action string, opts interface{}) error {
+	b, err := gophercloud.BuildRequestBody(opts, action)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Post(extensions.ActionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+
+	return err
+}
+
+func computeV2InstanceGetWithHost(client *gophercloud.ServiceClient, id string) (*computeV2InstanceServerWithHost, error) {
+	var server computeV2InstanceServerWithHost
+	err := servers.Get(client, id).ExtractInto(&server)
+	if err != nil {
+		return nil, err
+	}
+
+	return &server, nil
+}
+
+// computeV2InstanceMigrationRefreshFunc returns a resource.StateRefreshFunc
+// that is used to watch an instance migration. An ACTIVE or SHUTOFF instance
+// with a pending task is reported as MIGRATING, because the status isn't
+// updated until the compute service picks up the migration.
+func computeV2InstanceMigrationRefreshFunc(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		server, err := computeV2InstanceGetWithHost(client, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if server.TaskState != "" && (server.Status == "ACTIVE" || server.Status == "SHUTOFF") {
+			return server, "MIGRATING", nil
+		}
+
+		return server, server.Status, nil
+	}
+}
+
+// computeV2InstanceStartMigration starts the instance migration and returns
+// the pending and target states to wait for.
+func computeV2InstanceStartMigration(client *gophercloud.ServiceClient, id string, migration computeV2InstanceMigration) ([]string, []string, error) {
+	var (
+		err     error
+		pending = []string{"MIGRATING", "RESIZE", "REBUILD"}
+		target  = []string{"ACTIVE", "SHUTOFF"}
+	)
+
+	log.Printf("[DEBUG] openstack_compute_instance_v2 %s migration options: %#v", id, migration)
+
+	// Use a copy of the client, so that the migration microversion doesn't
+	// leak into the other requests of the caller.
+	migrationClient := *client
+
+	switch migration.Type {
+	case "live":
+		target = []string{"ACTIVE"}
+		opts := computeV2InstanceLiveMigrateOpts{
+			BlockMigration: expandComputeV2InstanceBlockMigration(migration.BlockMigration),
+		}
+		if migration.Host != "" {
+			opts.Host = &migration.Host
+		}
+		migrationClient.Microversion = computeV2InstanceLiveMigrateMicroversion
+		err = computeV2InstanceAction(&migrationClient, id, "os-migrateLive", opts)
+	case "cold":
+		if migration.IgnoreConfirmation {
+			pending = append(pending, "VERIFY_RESIZE")
+		} else {
+			target = append(target, "VERIFY_RESIZE")
+		}
+		if migration.Host != "" {
+			migrationClient.Microversion = computeV2InstanceMigrateHostMicroversion
+		}
+		err = computeV2InstanceAction(&migrationClient, id, "migrate", computeV2InstanceMigrateOpts{Host: migration.Host})
+	case "evacuate":
+		migrationClient.Microversion = computeV2InstanceEvacuateMicroversion
+		err = computeV2InstanceAction(&migrationClient, id, "evacuate", computeV2InstanceEvacuateOpts{Host: migration.Host})
+	default:
+		return nil, nil, fmt.Errorf("Unsupported migration type: %s", migration.Type)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error starting %s migration: %s", migration.Type, err)
+	}
+
+	return pending, target, nil
+}
+
+// computeV2InstanceMigrate moves the instance to the target compute host and
+// waits for the migration to finish. A cold migration, which ends up on
+// another host than requested, is reverted.
+func computeV2InstanceMigrate(ctx context.Context, client *gophercloud.ServiceClient, id string, migration computeV2InstanceMigration, timeout time.Duration) error {
+	pending, target, err := computeV2InstanceStartMigration(client, id, migration)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     target,
+		Refresh:    computeV2InstanceMigrationRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for openstack_compute_instance_v2 %s to migrate", id)
+	v, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Error waiting for %s migration: %s", migration.Type, err)
+	}
+
+	server := v.(*computeV2InstanceServerWithHost)
+	if server.Status == "VERIFY_RESIZE" {
+		return computeV2InstanceConfirmMigration(ctx, client, server, migration.Host, timeout)
+	}
+
+	if migration.Host != "" && server.Host != migration.Host {
+		return fmt.Errorf("Instance is running on %s host instead of %s after %s migration",
+			server.Host, migration.Host, migration.Type)
+	}
+
+	return nil
+}
+
+// computeV2InstanceConfirmMigration confirms a cold migration, when the
+// instance runs on the requested host. Otherwise the migration is reverted.
+func computeV2InstanceConfirmMigration(ctx context.Context, client *gophercloud.ServiceClient, server *computeV2InstanceServerWithHost, host string, timeout time.Duration) error {
+	revert := host != "" && server.Host != host
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"VERIFY_RESIZE", "REVERT_RESIZE"},
+		Target:     []string{"ACTIVE", "SHUTOFF"},
+		Refresh:    ServerV2StateRefreshFunc(client, server.ID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if revert {
+		log.Printf("[DEBUG] Reverting openstack_compute_instance_v2 %s migration to %s host", server.ID, server.Host)
+		err := servers.RevertResize(client, server.ID).ExtractErr()
+		if err != nil {
+			return fmt.Errorf("Error reverting migration: %s", err)
+		}
+
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return fmt.Errorf("Error waiting for migration revert: %s", err)
+		}
+
+		return fmt.Errorf("Instance was migrated to %s host instead of %s, the migration was reverted",
+			server.Host, host)
+	}
+
+	log.Printf("[DEBUG] Confirming openstack_compute_instance_v2 %s migration", server.ID)
+	err := servers.ConfirmResize(client, server.ID).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error confirming migration: %s", err)
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Error waiting for migration confirmation: %s", err)
+	}
+
+	return nil
+}
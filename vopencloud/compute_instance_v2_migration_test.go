@@ -0,0 +1,193 @@
+package vopencloud
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const testComputeV2InstanceMigrationServer = `
+{
+  "server": {
+    "id": "server_1",
+    "status": "%s",
+    "OS-EXT-STS:task_state": %s,
+    "OS-EXT-SRV-ATTR:host": "%s"
+  }
+}
+`
+
+func TestUnitComputeV2InstanceCreateOptsExt(t *testing.T) {
+	createOpts := computeV2InstanceCreateOptsExt{
+		CreateOptsBuilder: servers.CreateOpts{
+			Name:      "instance_1",
+			FlavorRef: "flavor_1",
+		},
+		Host: "compute-1",
+	}
+
+	expected := map[string]interface{}{
+		"server": map[string]interface{}{
+			"name":      "instance_1",
+			"flavorRef": "flavor_1",
+			"imageRef":  "",
+			"host":      "compute-1",
+		},
+	}
+
+	actual, err := createOpts.ToServerCreateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnitExpandComputeV2InstanceBlockMigration(t *testing.T) {
+	assert.Equal(t, "auto", expandComputeV2InstanceBlockMigration("auto"))
+	assert.Equal(t, true, expandComputeV2InstanceBlockMigration("true"))
+	assert.Equal(t, false, expandComputeV2InstanceBlockMigration("false"))
+}
+
+func TestUnitComputeV2InstanceMigrationRefreshFunc(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	status, taskState := "ACTIVE", `"migrating"`
+	th.Mux.HandleFunc("/servers/server_1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, testComputeV2InstanceMigrationServer, status, taskState, "compute-1")
+	})
+
+	refresh := computeV2InstanceMigrationRefreshFunc(thclient.ServiceClient(), "server_1")
+
+	_, state, err := refresh()
+	assert.NoError(t, err)
+	assert.Equal(t, "MIGRATING", state)
+
+	status, taskState = "ACTIVE", "null"
+	v, state, err := refresh()
+	assert.NoError(t, err)
+	assert.Equal(t, "ACTIVE", state)
+	assert.Equal(t, "compute-1", v.(*computeV2InstanceServerWithHost).Host)
+
+	status, taskState = "VERIFY_RESIZE", "null"
+	_, state, err = refresh()
+	assert.NoError(t, err)
+	assert.Equal(t, "VERIFY_RESIZE", state)
+}
+
+func TestUnitComputeV2InstanceStartMigrationLive(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/server_1/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-OpenStack-Nova-API-Version", "2.25")
+		th.TestJSONRequest(t, r, `{"os-migrateLive": {"host": "compute-2", "block_migration": "auto"}}`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	client := thclient.ServiceClient()
+	client.Type = "compute"
+
+	migration := computeV2InstanceMigration{
+		Type:           "live",
+		Host:           "compute-2",
+		BlockMigration: "auto",
+	}
+
+	pending, target, err := computeV2InstanceStartMigration(client, "server_1", migration)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"MIGRATING", "RESIZE", "REBUILD"}, pending)
+	assert.Equal(t, []string{"ACTIVE"}, target)
+	assert.Equal(t, "", client.Microversion)
+}
+
+func TestUnitComputeV2InstanceStartMigrationLiveWithoutHost(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/server_1/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-OpenStack-Nova-API-Version", "2.25")
+		th.TestJSONRequest(t, r, `{"os-migrateLive": {"host": null, "block_migration": false}}`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	client := thclient.ServiceClient()
+	client.Type = "compute"
+
+	migration := computeV2InstanceMigration{
+		Type:           "live",
+		BlockMigration: "false",
+	}
+
+	_, target, err := computeV2InstanceStartMigration(client, "server_1", migration)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ACTIVE"}, target)
+}
+
+func TestUnitComputeV2InstanceStartMigrationCold(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/server_1/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-OpenStack-Nova-API-Version", "2.56")
+		th.TestJSONRequest(t, r, `{"migrate": {"host": "compute-2"}}`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	client := thclient.ServiceClient()
+	client.Type = "compute"
+
+	migration := computeV2InstanceMigration{
+		Type: "cold",
+		Host: "compute-2",
+	}
+
+	pending, target, err := computeV2InstanceStartMigration(client, "server_1", migration)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"MIGRATING", "RESIZE", "REBUILD"}, pending)
+	assert.Equal(t, []string{"ACTIVE", "SHUTOFF", "VERIFY_RESIZE"}, target)
+
+	migration.IgnoreConfirmation = true
+	pending, target, err = computeV2InstanceStartMigration(client, "server_1", migration)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"MIGRATING", "RESIZE", "REBUILD", "VERIFY_RESIZE"}, pending)
+	assert.Equal(t, []string{"ACTIVE", "SHUTOFF"}, target)
+}
+
+func TestUnitComputeV2InstanceStartMigrationEvacuate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/server_1/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-OpenStack-Nova-API-Version", "2.29")
+		th.TestJSONRequest(t, r, `{"evacuate": {}}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{}`)
+	})
+
+	client := thclient.ServiceClient()
+	client.Type = "compute"
+
+	_, _, err := computeV2InstanceStartMigration(client, "server_1", computeV2InstanceMigration{Type: "evacuate"})
+	assert.NoError(t, err)
+
+	_, _, err = computeV2InstanceStartMigration(client, "server_1", computeV2InstanceMigration{Type: "unknown"})
+	assert.EqualError(t, err, "Unsupported migration type: unknown")
+}
@@ -2,12 +2,17 @@ package vopencloud
 
 import (
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/apiversions"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
 )
 
 const (
 	antiAffinityPolicy = "anti-affinity"
 	affinityPolicy     = "affinity"
+
+	// computeV2ServerGroupRulesMicroversion is the minimal microversion,
+	// which supports the server group policy rules.
+	computeV2ServerGroupRulesMicroversion = "2.64"
 )
 
 // ServerGroupCreateOpts is a custom ServerGroup struct to include the
@@ -55,3 +60,46 @@ func expandComputeServerGroupV2RulesMaxServerPerHost(raw []interface{}) int {
 	}
 	return 0
 }
+
+// computeV2MaxMicroversion returns the maximum microversion supported by the
+// Compute v2.1 API.
+func computeV2MaxMicroversion(client *gophercloud.ServiceClient) (string, error) {
+	v, err := apiversions.Get(client, "v2.1").Extract()
+	if err != nil {
+		return "", err
+	}
+
+	return v.Version, nil
+}
+
+// computeV2ServerGroupRulesSupported returns the maximum Compute API
+// microversion and whether it supports the server group policy rules.
+func computeV2ServerGroupRulesSupported(client *gophercloud.ServiceClient) (string, bool, error) {
+	maxMicroversion, err := computeV2MaxMicroversion(client)
+	if err != nil {
+		return "", false, err
+	}
+
+	ok, err := compatibleMicroversion("min", computeV2ServerGroupRulesMicroversion, maxMicroversion)
+	if err != nil {
+		return "", false, err
+	}
+
+	return maxMicroversion, ok, nil
+}
+
+func flattenComputeServerGroupV2Policies(sg *servergroups.ServerGroup) []string {
+	if sg.Policy != nil && *sg.Policy != "" {
+		return []string{*sg.Policy}
+	}
+
+	return sg.Policies
+}
+
+func flattenComputeServerGroupV2Rules(rules *servergroups.Rules) []map[string]interface{} {
+	if rules == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{{"max_server_per_host": rules.MaxServerPerHost}}
+}
@@ -1,6 +1,8 @@
 package vopencloud
 
 import (
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -82,3 +84,60 @@ func TestUnitExpandComputeServerGroupV2PoliciesMicroversionsLegacy(t *testing.T)
 	assert.Equal(t, expectedMicroversion, actualMicroversion)
 	assert.Equal(t, expectedPolicies, actualPolicies)
 }
+
+func TestUnitComputeV2ServerGroupRulesSupported(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	maxMicroversion := "2.60"
+	th.Mux.HandleFunc("/v2.1/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+{
+  "version": {
+    "id": "v2.1",
+    "min_version": "2.1",
+    "status": "CURRENT",
+    "updated": "2013-07-23T11:33:21Z",
+    "version": "%s"
+  }
+}
+`, maxMicroversion)
+	})
+
+	client := thclient.ServiceClient()
+
+	actualMicroversion, ok, err := computeV2ServerGroupRulesSupported(client)
+	assert.NoError(t, err)
+	assert.Equal(t, "2.60", actualMicroversion)
+	assert.False(t, ok)
+
+	maxMicroversion = "2.95"
+	actualMicroversion, ok, err = computeV2ServerGroupRulesSupported(client)
+	assert.NoError(t, err)
+	assert.Equal(t, "2.95", actualMicroversion)
+	assert.True(t, ok)
+}
+
+func TestUnitFlattenComputeServerGroupV2(t *testing.T) {
+	policy := "anti-affinity"
+	sg := &servergroups.ServerGroup{
+		Policy: &policy,
+		Rules: &servergroups.Rules{
+			MaxServerPerHost: 2,
+		},
+	}
+
+	assert.Equal(t, []string{"anti-affinity"}, flattenComputeServerGroupV2Policies(sg))
+	assert.Equal(t, []map[string]interface{}{{"max_server_per_host": 2}}, flattenComputeServerGroupV2Rules(sg.Rules))
+
+	sg = &servergroups.ServerGroup{
+		Policies: []string{"affinity"},
+	}
+
+	assert.Equal(t, []string{"affinity"}, flattenComputeServerGroupV2Policies(sg))
+	assert.Nil(t, flattenComputeServerGroupV2Rules(sg.Rules))
+}
@@ -0,0 +1,155 @@
+package vopencloud
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/backups"
+)
+
+func dataSourceBlockStorageBackupV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBlockStorageBackupV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"volume_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			// Computed values
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"container": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"incremental": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"object_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"has_dependent_backups": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceBlockStorageBackupV3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	listOpts := blockStorageBackupV3ListOpts{
+		Name:     d.Get("name").(string),
+		Status:   d.Get("status").(string),
+		VolumeID: d.Get("volume_id").(string),
+	}
+
+	allPages, err := backups.ListDetail(client, listOpts).AllPages()
+	if err != nil {
+		return diag.Errorf("Unable to query openstack_blockstorage_backups_v3: %s", err)
+	}
+
+	allBackups, err := backups.ExtractBackups(allPages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_blockstorage_backups_v3: %s", err)
+	}
+
+	if len(allBackups) < 1 {
+		return diag.Errorf("Your openstack_blockstorage_backup_v3 query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	var backup backups.Backup
+	if len(allBackups) > 1 {
+		recent := d.Get("most_recent").(bool)
+
+		if recent {
+			backup = dataSourceBlockStorageV3MostRecentBackup(allBackups)
+		} else {
+			log.Printf("[DEBUG] Multiple openstack_blockstorage_backup_v3 results found: %#v", allBackups)
+
+			return diag.Errorf("Your query returned more than one result. Please try a more " +
+				"specific search criteria, or set `most_recent` attribute to true.")
+		}
+	} else {
+		backup = allBackups[0]
+	}
+
+	dataSourceBlockStorageBackupV3Attributes(d, backup)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func dataSourceBlockStorageBackupV3Attributes(d *schema.ResourceData, backup backups.Backup) {
+	d.SetId(backup.ID)
+	d.Set("name", backup.Name)
+	d.Set("description", backup.Description)
+	d.Set("size", backup.Size)
+	d.Set("status", backup.Status)
+	d.Set("volume_id", backup.VolumeID)
+	d.Set("container", backup.Container)
+	d.Set("incremental", backup.IsIncremental)
+	d.Set("snapshot_id", backup.SnapshotID)
+	d.Set("object_count", backup.ObjectCount)
+	d.Set("has_dependent_backups", backup.HasDependentBackups)
+	d.Set("created_at", backup.CreatedAt.Format(time.RFC3339))
+}
@@ -0,0 +1,54 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBlockStorageV3BackupDataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_blockstorage_backup_v3.backup_1"
+	backupName := acctest.RandomWithPrefix("tf-acc-backup")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckBlockStorageBackup(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3BackupDataSourceBasic(backupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"openstack_blockstorage_backup_v3.backup_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", backupName),
+					resource.TestCheckResourceAttrPair(resourceName, "volume_id",
+						"openstack_blockstorage_volume_v3.volume_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBlockStorageV3BackupDataSourceBasic(backupName string) string {
+	return fmt.Sprintf(`
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "%[1]s"
+  size = 1
+}
+
+resource "openstack_blockstorage_backup_v3" "backup_1" {
+  name      = "%[1]s"
+  volume_id = openstack_blockstorage_volume_v3.volume_1.id
+}
+
+data "openstack_blockstorage_backup_v3" "backup_1" {
+  name = openstack_blockstorage_backup_v3.backup_1.name
+}
+`, backupName)
+}
@@ -0,0 +1,258 @@
+package vopencloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	"github.com/gophercloud/gophercloud/pagination"
+	"github.com/gophercloud/utils/terraform/hashcode"
+)
+
+func dataSourceComputeFlavorsV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceComputeFlavorsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"min_vcpus": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"max_vcpus": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"min_ram": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"max_ram": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"min_disk": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"max_disk": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"is_public": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"extra_specs": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"extra_spec_keys": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			// Computed values
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"flavors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"vcpus": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"ram": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"disk": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"swap": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"ephemeral": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"rx_tx_factor": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+
+						"is_public": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dataSourceComputeFlavorsV2Read performs the flavors lookup.
+func dataSourceComputeFlavorsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	computeClient, err := config.ComputeV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	accessType := flavors.AllAccess
+	if v, ok := d.GetOkExists("is_public"); ok {
+		if v, ok := v.(bool); ok {
+			if v {
+				accessType = flavors.PublicAccess
+			} else {
+				accessType = flavors.PrivateAccess
+			}
+		}
+	}
+	listOpts := flavors.ListOpts{
+		MinDisk:    d.Get("min_disk").(int),
+		MinRAM:     d.Get("min_ram").(int),
+		AccessType: accessType,
+	}
+
+	log.Printf("[DEBUG] openstack_compute_flavors_v2 ListOpts: %#v", listOpts)
+
+	var allPages pagination.Page
+	// try and read flavors using microversion that includes description
+	computeClient.Microversion = computeV2FlavorDescriptionMicroversion
+	allPages, err = flavors.ListDetail(computeClient, listOpts).AllPages()
+	if err != nil {
+		// reset microversion to 2.1 and try again
+		computeClient.Microversion = "2.1"
+		allPages, err = flavors.ListDetail(computeClient, listOpts).AllPages()
+		if err != nil {
+			return diag.Errorf("Unable to query OpenStack flavors: %s", err)
+		}
+	}
+
+	allFlavors, err := flavors.ExtractFlavors(allPages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve OpenStack flavors: %s", err)
+	}
+
+	filter := computeFlavorsV2Filter{
+		MinVCPUs:      d.Get("min_vcpus").(int),
+		MaxVCPUs:      d.Get("max_vcpus").(int),
+		MinRAM:        d.Get("min_ram").(int),
+		MaxRAM:        d.Get("max_ram").(int),
+		MaxDisk:       d.Get("max_disk").(int),
+		ExtraSpecs:    expandToMapStringString(d.Get("extra_specs").(map[string]interface{})),
+		ExtraSpecKeys: expandToStringSlice(d.Get("extra_spec_keys").(*schema.Set).List()),
+	}
+	if v := d.Get("name_regex").(string); v != "" {
+		filter.NameRegex = regexp.MustCompile(v)
+	}
+
+	allFlavors = computeFlavorsV2FilterBySize(allFlavors, filter)
+
+	// Extra specs aren't included in the flavors list and have to be
+	// retrieved per flavor.
+	if len(filter.ExtraSpecs) > 0 || len(filter.ExtraSpecKeys) > 0 {
+		var filteredFlavors []flavors.Flavor
+		for _, flavor := range allFlavors {
+			extraSpecs, err := flavors.ListExtraSpecs(computeClient, flavor.ID).Extract()
+			if err != nil {
+				return diag.Errorf("Unable to retrieve extra_specs of OpenStack %s flavor: %s", flavor.ID, err)
+			}
+
+			if computeFlavorV2MatchExtraSpecs(extraSpecs, filter) {
+				filteredFlavors = append(filteredFlavors, flavor)
+			}
+		}
+
+		allFlavors = filteredFlavors
+	}
+
+	log.Printf("[DEBUG] Got %d flavors after filtering in openstack_compute_flavors_v2: %+v", len(allFlavors), allFlavors)
+
+	flavorIDs := make([]string, len(allFlavors))
+	for i, flavor := range allFlavors {
+		flavorIDs[i] = flavor.ID
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(strings.Join(flavorIDs, ","))))
+	d.Set("ids", flavorIDs)
+	d.Set("flavors", flattenComputeFlavorsV2(allFlavors))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccComputeV2FlavorsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2FlavorsDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2FlavorDataSourceID("data.openstack_compute_flavors_v2.flavors_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_flavors_v2.flavors_1", "ids.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_flavors_v2.flavors_1", "flavors.0.name", "m1.acctest"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_flavors_v2.flavors_1", "flavors.0.ram", "512"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_flavors_v2.flavors_1", "flavors.0.disk", "10"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_flavors_v2.flavors_1", "flavors.0.vcpus", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2FlavorsDataSource_extraSpecs(t *testing.T) {
+	var flavorName = acctest.RandomWithPrefix("tf-acc-flavor")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2FlavorExtraSpecs1(flavorName),
+			},
+			{
+				Config: testAccComputeV2FlavorsDataSourceExtraSpecs(flavorName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2FlavorDataSourceID("data.openstack_compute_flavors_v2.flavors_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_flavors_v2.flavors_1", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_compute_flavors_v2.flavors_1", "ids.0",
+						"openstack_compute_flavor_v2.flavor_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_flavors_v2.flavors_1", "flavors.0.name", flavorName),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_flavors_v2.flavors_1", "flavors.0.description", "foo"),
+				),
+			},
+		},
+	})
+}
+
+const testAccComputeV2FlavorsDataSourceBasic = `
+data "openstack_compute_flavors_v2" "flavors_1" {
+  name_regex = "^m1\\.acctest$"
+  min_vcpus = 1
+  max_vcpus = 1
+  min_ram = 512
+  max_ram = 512
+}
+`
+
+func testAccComputeV2FlavorsDataSourceExtraSpecs(flavorName string) string {
+	flavorResource := testAccComputeV2FlavorExtraSpecs1(flavorName)
+
+	return fmt.Sprintf(`
+          %s
+
+          data "openstack_compute_flavors_v2" "flavors_1" {
+            name_regex = "^${openstack_compute_flavor_v2.flavor_1.name}$"
+            min_vcpus = 2
+            max_ram = 2048
+
+            extra_specs = {
+              "hw:cpu_policy" = "CPU-POLICY"
+            }
+
+            extra_spec_keys = [
+              "hw:cpu_thread_policy",
+            ]
+          }
+          `, flavorResource)
+}
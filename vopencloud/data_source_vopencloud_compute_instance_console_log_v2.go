@@ -0,0 +1,65 @@
+package vopencloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+)
+
+func dataSourceComputeInstanceConsoleLogV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceComputeInstanceConsoleLogV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"output": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeInstanceConsoleLogV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	computeClient, err := config.ComputeV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	opts := servers.ShowConsoleOutputOpts{
+		Length: d.Get("length").(int),
+	}
+
+	output, err := servers.ShowConsoleOutput(computeClient, instanceID, opts).Extract()
+	if err != nil {
+		return diag.Errorf("Error retrieving openstack_compute_instance_console_log_v2 for %s instance: %s", instanceID, err)
+	}
+
+	d.SetId(instanceID)
+	d.Set("output", output)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccComputeV2InstanceConsoleLogDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2InstanceConsoleLogDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_compute_instance_console_log_v2.console_log_1", "id",
+						"openstack_compute_instance_v2.instance_1", "id"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_compute_instance_console_log_v2.console_log_1", "output"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComputeV2InstanceConsoleLogDataSourceBasic() string {
+	return fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  network {
+    uuid = "%s"
+  }
+}
+
+data "openstack_compute_instance_console_log_v2" "console_log_1" {
+  instance_id = "${openstack_compute_instance_v2.instance_1.id}"
+  length      = 50
+}
+`, osNetworkID)
+}
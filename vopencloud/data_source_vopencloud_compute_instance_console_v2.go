@@ -0,0 +1,91 @@
+package vopencloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/remoteconsoles"
+)
+
+func dataSourceComputeInstanceConsoleV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceComputeInstanceConsoleV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "vnc",
+				ValidateFunc: validation.StringInSlice([]string{
+					"vnc", "spice", "serial", "rdp", "mks",
+				}, false),
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"novnc", "xvpvnc", "spice-html5", "serial", "rdp-html5", "webmks",
+				}, false),
+			},
+
+			"url": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeInstanceConsoleV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	computeClient, err := config.ComputeV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	protocol := d.Get("protocol").(string)
+	consoleType, err := expandComputeV2InstanceConsoleType(protocol, d.Get("type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createOpts := remoteconsoles.CreateOpts{
+		Protocol: remoteconsoles.ConsoleProtocol(protocol),
+		Type:     remoteconsoles.ConsoleType(consoleType),
+	}
+
+	log.Printf("[DEBUG] openstack_compute_instance_console_v2 create options: %#v", createOpts)
+
+	computeClient.Microversion = computeV2InstanceConsoleMicroversion(protocol)
+	console, err := remoteconsoles.Create(computeClient, instanceID, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_compute_instance_console_v2 for %s instance: %s", instanceID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, protocol))
+	d.Set("type", console.Type)
+	d.Set("url", console.URL)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package vopencloud
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccComputeV2InstanceConsoleDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2InstanceConsoleDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_instance_console_v2.console_1", "protocol", "vnc"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_instance_console_v2.console_1", "type", "novnc"),
+					resource.TestMatchResourceAttr(
+						"data.openstack_compute_instance_console_v2.console_1", "url", regexp.MustCompile("^https?://")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2InstanceConsoleDataSource_invalidType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccComputeV2InstanceConsoleDataSourceInvalidType(),
+				ExpectError: regexp.MustCompile("Console type novnc isn't supported by spice protocol"),
+			},
+		},
+	})
+}
+
+func testAccComputeV2InstanceConsoleDataSourceBasic() string {
+	return fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  network {
+    uuid = "%s"
+  }
+}
+
+data "openstack_compute_instance_console_v2" "console_1" {
+  instance_id = "${openstack_compute_instance_v2.instance_1.id}"
+}
+`, osNetworkID)
+}
+
+func testAccComputeV2InstanceConsoleDataSourceInvalidType() string {
+	return fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  network {
+    uuid = "%s"
+  }
+}
+
+data "openstack_compute_instance_console_v2" "console_1" {
+  instance_id = "${openstack_compute_instance_v2.instance_1.id}"
+  protocol    = "spice"
+  type        = "novnc"
+}
+`, osNetworkID)
+}
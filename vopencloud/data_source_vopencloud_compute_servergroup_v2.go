@@ -0,0 +1,161 @@
+package vopencloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
+)
+
+func dataSourceComputeServerGroupV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceComputeServerGroupV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"servergroup_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"name", "all_projects"},
+			},
+
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"servergroup_id"},
+			},
+
+			"all_projects": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"servergroup_id"},
+			},
+
+			// computed-only
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_server_per_host": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeServerGroupV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	computeClient, err := config.ComputeV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	// Read the rules, when the Compute API supports them.
+	_, ok, err := computeV2ServerGroupRulesSupported(computeClient)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to determine Compute API microversion for openstack_compute_servergroup_v2: %s", err)
+	}
+	if ok {
+		computeClient.Microversion = computeV2ServerGroupRulesMicroversion
+	}
+
+	var sg *servergroups.ServerGroup
+	if v := d.Get("servergroup_id").(string); v != "" {
+		sg, err = servergroups.Get(computeClient, v).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return diag.Errorf("No openstack_compute_servergroup_v2 found")
+			}
+			return diag.Errorf("Unable to retrieve openstack_compute_servergroup_v2 %s: %s", v, err)
+		}
+	} else {
+		listOpts := servergroups.ListOpts{
+			AllProjects: d.Get("all_projects").(bool),
+		}
+
+		log.Printf("[DEBUG] openstack_compute_servergroup_v2 ListOpts: %#v", listOpts)
+
+		allPages, err := servergroups.List(computeClient, listOpts).AllPages()
+		if err != nil {
+			return diag.Errorf("Unable to query openstack_compute_servergroup_v2: %s", err)
+		}
+
+		allServerGroups, err := servergroups.ExtractServerGroups(allPages)
+		if err != nil {
+			return diag.Errorf("Unable to retrieve openstack_compute_servergroup_v2: %s", err)
+		}
+
+		name := d.Get("name").(string)
+		var filteredServerGroups []servergroups.ServerGroup
+		for _, v := range allServerGroups {
+			if name != "" && v.Name != name {
+				continue
+			}
+
+			filteredServerGroups = append(filteredServerGroups, v)
+		}
+
+		if len(filteredServerGroups) < 1 {
+			return diag.Errorf("Your query returned no results. " +
+				"Please change your search criteria and try again.")
+		}
+
+		if len(filteredServerGroups) > 1 {
+			log.Printf("[DEBUG] Multiple results found: %#v", filteredServerGroups)
+			return diag.Errorf("Your query returned more than one result. " +
+				"Please try a more specific search criteria")
+		}
+
+		sg = &filteredServerGroups[0]
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_compute_servergroup_v2 %s: %#v", sg.ID, sg)
+
+	d.SetId(sg.ID)
+	d.Set("name", sg.Name)
+	d.Set("members", sg.Members)
+	d.Set("project_id", sg.ProjectID)
+	d.Set("user_id", sg.UserID)
+	d.Set("region", GetRegion(d, config))
+	d.Set("policies", flattenComputeServerGroupV2Policies(sg))
+	d.Set("rules", flattenComputeServerGroupV2Rules(sg.Rules))
+
+	return nil
+}
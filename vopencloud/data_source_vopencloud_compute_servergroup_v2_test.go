@@ -0,0 +1,96 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccComputeV2ServerGroupDataSource_basic(t *testing.T) {
+	var sgName = acctest.RandomWithPrefix("tf-acc-sg")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckComputeV2ServerGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2ServerGroupDataSourceBasic(sgName),
+			},
+			{
+				Config: testAccComputeV2ServerGroupDataSourceName(sgName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_compute_servergroup_v2.sg_1", "id",
+						"openstack_compute_servergroup_v2.sg_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_servergroup_v2.sg_1", "policies.0", "anti-affinity"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_servergroup_v2.sg_1", "rules.0.max_server_per_host", "2"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_servergroup_v2.sg_1", "members.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_compute_servergroup_v2.sg_1", "members.0",
+						"openstack_compute_instance_v2.instance_1", "id"),
+				),
+			},
+			{
+				Config: testAccComputeV2ServerGroupDataSourceID(sgName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_servergroup_v2.sg_1", "name", sgName),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_servergroup_v2.sg_1", "members.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComputeV2ServerGroupDataSourceBasic(sgName string) string {
+	return fmt.Sprintf(`
+resource "openstack_compute_servergroup_v2" "sg_1" {
+  name = "%s"
+  policies = ["anti-affinity"]
+  rules {
+    max_server_per_host = 2
+  }
+}
+
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  scheduler_hints {
+    group = "${openstack_compute_servergroup_v2.sg_1.id}"
+  }
+  network {
+    uuid = "%s"
+  }
+}
+`, sgName, osNetworkID)
+}
+
+func testAccComputeV2ServerGroupDataSourceName(sgName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_compute_servergroup_v2" "sg_1" {
+  name = "${openstack_compute_servergroup_v2.sg_1.name}"
+}
+`, testAccComputeV2ServerGroupDataSourceBasic(sgName))
+}
+
+func testAccComputeV2ServerGroupDataSourceID(sgName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_compute_servergroup_v2" "sg_1" {
+  servergroup_id = "${openstack_compute_servergroup_v2.sg_1.id}"
+}
+`, testAccComputeV2ServerGroupDataSourceBasic(sgName))
+}
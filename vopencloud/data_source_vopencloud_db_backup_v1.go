@@ -0,0 +1,189 @@
+package vopencloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDatabaseBackupV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDatabaseBackupV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"backup_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"instance_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			// Computed values
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"parent_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"incremental": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"size": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"location_ref": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"datastore": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDatabaseBackupV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	DatabaseV1Client, err := config.DatabaseV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack database client: %s", err)
+	}
+
+	if id := d.Get("backup_id").(string); id != "" {
+		backup, err := databaseBackupV1Get(DatabaseV1Client, id)
+		if err != nil {
+			return diag.Errorf("Unable to retrieve openstack_db_backup_v1 %s: %s", id, err)
+		}
+
+		dataSourceDatabaseBackupV1Attributes(d, *backup)
+		d.Set("region", GetRegion(d, config))
+
+		return nil
+	}
+
+	listOpts := databaseBackupV1ListOpts{
+		InstanceID: d.Get("instance_id").(string),
+	}
+
+	allBackups, err := databaseBackupV1List(DatabaseV1Client, listOpts)
+	if err != nil {
+		return diag.Errorf("Unable to query openstack_db_backups_v1: %s", err)
+	}
+
+	// The Trove API doesn't support filtering by name.
+	var filteredBackups []databaseBackupV1
+	name := d.Get("name").(string)
+	instanceID := d.Get("instance_id").(string)
+	for _, backup := range allBackups {
+		if name != "" && backup.Name != name {
+			continue
+		}
+		if instanceID != "" && backup.InstanceID != instanceID {
+			continue
+		}
+		filteredBackups = append(filteredBackups, backup)
+	}
+
+	if len(filteredBackups) < 1 {
+		return diag.Errorf("Your openstack_db_backup_v1 query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	var backup databaseBackupV1
+	if len(filteredBackups) > 1 {
+		recent := d.Get("most_recent").(bool)
+
+		if recent {
+			backup = databaseBackupV1MostRecent(filteredBackups)
+		} else {
+			log.Printf("[DEBUG] Multiple openstack_db_backup_v1 results found: %#v", filteredBackups)
+
+			return diag.Errorf("Your query returned more than one result. Please try a more " +
+				"specific search criteria, or set `most_recent` attribute to true.")
+		}
+	} else {
+		backup = filteredBackups[0]
+	}
+
+	dataSourceDatabaseBackupV1Attributes(d, backup)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func dataSourceDatabaseBackupV1Attributes(d *schema.ResourceData, backup databaseBackupV1) {
+	d.SetId(backup.ID)
+	d.Set("backup_id", backup.ID)
+	d.Set("name", backup.Name)
+	d.Set("instance_id", backup.InstanceID)
+	d.Set("description", backup.Description)
+	d.Set("parent_id", backup.ParentID)
+	d.Set("incremental", backup.ParentID != "")
+	d.Set("size", backup.Size)
+	d.Set("status", backup.Status)
+	d.Set("location_ref", backup.LocationRef)
+	d.Set("datastore", flattenDatabaseBackupV1Datastore(backup.Datastore))
+	d.Set("created", backup.Created)
+	d.Set("updated", backup.Updated)
+}
@@ -0,0 +1,47 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatabaseV1BackupDataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_db_backup_v1.backup_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1BackupDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"openstack_db_backup_v1.incremental", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "parent_id",
+						"openstack_db_backup_v1.full", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id",
+						"openstack_db_instance_v1.basic", "id"),
+					resource.TestCheckResourceAttr(resourceName, "incremental", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", "COMPLETED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatabaseV1BackupDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_db_backup_v1" "backup_1" {
+  instance_id = openstack_db_backup_v1.incremental.instance_id
+  most_recent = true
+}
+`, testAccDatabaseV1BackupBasic())
+}
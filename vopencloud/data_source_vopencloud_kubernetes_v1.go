@@ -2,9 +2,12 @@ package vopencloud
 
 import (
 	"context"
+	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
 )
 
 func dataSourceKubernetesV1() *schema.Resource {
@@ -18,27 +21,114 @@ func dataSourceKubernetesV1() *schema.Resource {
 				Computed: true,
 			},
 
+			"cluster_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name"},
+			},
+
 			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"cluster_id"},
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"cluster_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"network_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"endpoint": {
 				Type:     schema.TypeString,
-				Optional: true,
 				Computed: true,
 			},
 
 			"status": {
 				Type:     schema.TypeString,
-				Optional: true,
 				Computed: true,
 			},
 
+			"node_pool": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"flavor_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"node_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"metadata": {
 				Type:     schema.TypeMap,
-				Optional: true,
 				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
-			"host": {
+			"kubeconfig": {
+				Type:      schema.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": {
 				Type:     schema.TypeString,
-				Optional: true,
 				Computed: true,
 			},
 		},
@@ -46,15 +136,77 @@ func dataSourceKubernetesV1() *schema.Resource {
 }
 
 func dataSourceKubernetesV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	dataSourceKubernetesV1Attributes(d)
+	config := meta.(*Config)
+	kubernetesClient, err := config.KubernetesV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack kubernetes client: %s", err)
+	}
 
-	return nil
-}
+	var allClusters []kubernetesClusterV1
 
-func dataSourceKubernetesV1Attributes(d *schema.ResourceData) {
-	d.SetId("server1")
-	d.Set("name", "server1")
-	d.Set("status", "PEDNING")
-	d.Set("host", "localhost")
+	if clusterID := d.Get("cluster_id").(string); clusterID != "" {
+		c, err := kubernetesClusterV1Get(kubernetesClient, clusterID)
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); !ok {
+				return diag.Errorf("Unable to retrieve openstack_kubernetes_v1 %s: %s", clusterID, err)
+			}
+		} else {
+			allClusters = append(allClusters, *c)
+		}
+	} else {
+		name := d.Get("name").(string)
+		clusters, err := kubernetesClusterV1List(kubernetesClient, kubernetesClusterV1ListOpts{
+			Name: name,
+		})
+		if err != nil {
+			return diag.Errorf("Unable to list openstack_kubernetes_v1 clusters: %s", err)
+		}
 
+		for _, c := range clusters {
+			if name != "" && c.Name != name {
+				continue
+			}
+			allClusters = append(allClusters, c)
+		}
+	}
+
+	if len(allClusters) < 1 {
+		return diag.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allClusters) > 1 {
+		return diag.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	c := allClusters[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_kubernetes_v1 %s: %#v", c.ID, c)
+
+	d.SetId(c.ID)
+	d.Set("cluster_id", c.ID)
+	d.Set("name", c.Name)
+	d.Set("project_id", c.ProjectID)
+	d.Set("cluster_version", c.Version)
+	d.Set("network_id", c.NetworkID)
+	d.Set("subnet_id", c.SubnetID)
+	d.Set("endpoint", c.Endpoint)
+	d.Set("status", c.Status)
+	d.Set("metadata", c.Metadata)
+	d.Set("created_at", c.CreatedAt)
+	d.Set("updated_at", c.UpdatedAt)
+	d.Set("region", GetRegion(d, config))
+
+	if err := d.Set("node_pool", flattenKubernetesV1NodePools(c.NodePools)); err != nil {
+		return diag.Errorf("Unable to set openstack_kubernetes_v1 node_pool: %s", err)
+	}
+
+	kubeconfig, err := flattenKubernetesV1Kubeconfig(kubernetesClient, &c)
+	if err != nil {
+		return diag.Errorf("Error building kubeconfig for openstack_kubernetes_v1 %s: %s", c.ID, err)
+	}
+	d.Set("kubeconfig", kubeconfig)
+
+	return nil
 }
@@ -0,0 +1,67 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKubernetesV1DataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_kubernetes_v1.cluster_1"
+	clusterName := acctest.RandomWithPrefix("tf-acc-kubernetes")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckKubernetes(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckKubernetesV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesV1Basic(clusterName, 1),
+			},
+			{
+				Config: testAccKubernetesV1DataSourceBasic(testAccKubernetesV1Basic(clusterName, 1)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKubernetesV1DataSourceID(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", clusterName),
+					resource.TestCheckResourceAttr(resourceName, "cluster_version", osKubernetesVersion),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "node_pool.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "kubeconfig.raw_config"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesV1DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find kubernetes data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Kubernetes data source ID is not set")
+		}
+
+		return nil
+	}
+}
+
+func testAccKubernetesV1DataSourceBasic(clusterResource string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_kubernetes_v1" "cluster_1" {
+  name = "${openstack_kubernetes_v1.cluster_1.name}"
+}
+`, clusterResource)
+}
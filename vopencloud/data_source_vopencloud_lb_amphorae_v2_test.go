@@ -0,0 +1,49 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLBV2AmphoraeDataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_lb_amphorae_v2.amphorae_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2DataSourceBase,
+			},
+			{
+				Config: testAccLbV2AmphoraeDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "loadbalancer_id",
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "amphorae.0.id"),
+					resource.TestCheckResourceAttrSet(resourceName, "amphorae.0.role"),
+					resource.TestCheckResourceAttrSet(resourceName, "amphorae.0.compute_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "amphorae.0.lb_network_ip"),
+					resource.TestCheckResourceAttr(resourceName, "amphorae.0.status", "ALLOCATED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLbV2AmphoraeDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_lb_amphorae_v2" "amphorae_1" {
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+`, testAccLbV2DataSourceBase)
+}
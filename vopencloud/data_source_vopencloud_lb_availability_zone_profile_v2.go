@@ -0,0 +1,94 @@
+package vopencloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLBAvailabilityZoneProfileV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBAvailabilityZoneProfileV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"availability_zone_profile_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"provider_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			// Computed values
+			"availability_zone_data": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLBAvailabilityZoneProfileV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error reading openstack_lb_availability_zone_profile_v2: Only available when using octavia")
+	}
+
+	listOpts := lbAvailabilityZoneProfileV2ListOpts{
+		ID:           d.Get("availability_zone_profile_id").(string),
+		Name:         d.Get("name").(string),
+		ProviderName: d.Get("provider_name").(string),
+	}
+
+	azps, err := lbAvailabilityZoneProfileV2List(lbClient, listOpts)
+	if err != nil {
+		return diag.Errorf("Unable to query openstack_lb_availability_zone_profile_v2: %s", err)
+	}
+
+	if len(azps) < 1 {
+		return diag.Errorf("Your openstack_lb_availability_zone_profile_v2 query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(azps) > 1 {
+		log.Printf("[DEBUG] Multiple openstack_lb_availability_zone_profile_v2 results found: %#v", azps)
+		return diag.Errorf("Your openstack_lb_availability_zone_profile_v2 query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	azp := azps[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_availability_zone_profile_v2 %s: %#v", azp.ID, azp)
+
+	d.SetId(azp.ID)
+	d.Set("availability_zone_profile_id", azp.ID)
+	d.Set("name", azp.Name)
+	d.Set("provider_name", azp.ProviderName)
+	d.Set("availability_zone_data", normalizeLBV2JSONData(azp.AvailabilityZoneData))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
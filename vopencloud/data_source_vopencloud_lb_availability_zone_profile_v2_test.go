@@ -0,0 +1,46 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLBAvailabilityZoneProfileV2DataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_lb_availability_zone_profile_v2.azp_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBAvailabilityZoneProfileV2Basic,
+			},
+			{
+				Config: testAccLBAvailabilityZoneProfileV2DataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"openstack_lb_availability_zone_profile_v2.azp_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "provider_name", "amphora"),
+					resource.TestCheckResourceAttr(resourceName, "availability_zone_data", `{"compute_zone":"nova"}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccLBAvailabilityZoneProfileV2DataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_lb_availability_zone_profile_v2" "azp_1" {
+  name = openstack_lb_availability_zone_profile_v2.azp_1.name
+}
+`, testAccLBAvailabilityZoneProfileV2Basic)
+}
@@ -0,0 +1,73 @@
+package vopencloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLBAvailabilityZoneV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBAvailabilityZoneV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Computed values
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"availability_zone_profile_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLBAvailabilityZoneV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error reading openstack_lb_availability_zone_v2: Only available when using octavia")
+	}
+
+	name := d.Get("name").(string)
+	az, err := lbAvailabilityZoneV2Get(lbClient, name)
+	if err != nil {
+		return diag.Errorf("Error retrieving openstack_lb_availability_zone_v2 %s: %s", name, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_availability_zone_v2 %s: %#v", name, az)
+
+	d.SetId(az.Name)
+	d.Set("name", az.Name)
+	d.Set("description", az.Description)
+	d.Set("availability_zone_profile_id", az.AvailabilityZoneProfileID)
+	d.Set("enabled", az.Enabled)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
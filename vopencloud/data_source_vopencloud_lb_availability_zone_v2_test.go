@@ -0,0 +1,47 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLBAvailabilityZoneV2DataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_lb_availability_zone_v2.az_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBAvailabilityZoneV2Basic,
+			},
+			{
+				Config: testAccLBAvailabilityZoneV2DataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"openstack_lb_availability_zone_v2.az_1", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "availability_zone_profile_id",
+						"openstack_lb_availability_zone_profile_v2.azp_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLBAvailabilityZoneV2DataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_lb_availability_zone_v2" "az_1" {
+  name = openstack_lb_availability_zone_v2.az_1.name
+}
+`, testAccLBAvailabilityZoneV2Basic)
+}
@@ -0,0 +1,100 @@
+package vopencloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLBFlavorV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBFlavorV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"flavor_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"flavor_id", "name"},
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"flavor_id", "name"},
+			},
+
+			// Computed values
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"flavor_profile_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLBFlavorV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error reading openstack_lb_flavor_v2: Only available when using octavia")
+	}
+
+	listOpts := lbFlavorV2ListOpts{
+		ID:   d.Get("flavor_id").(string),
+		Name: d.Get("name").(string),
+	}
+
+	flavors, err := lbFlavorV2List(lbClient, listOpts)
+	if err != nil {
+		return diag.Errorf("Unable to query openstack_lb_flavor_v2: %s", err)
+	}
+
+	if len(flavors) < 1 {
+		return diag.Errorf("Your openstack_lb_flavor_v2 query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(flavors) > 1 {
+		log.Printf("[DEBUG] Multiple openstack_lb_flavor_v2 results found: %#v", flavors)
+		return diag.Errorf("Your openstack_lb_flavor_v2 query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	flavor := flavors[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_flavor_v2 %s: %#v", flavor.ID, flavor)
+
+	d.SetId(flavor.ID)
+	d.Set("flavor_id", flavor.ID)
+	d.Set("name", flavor.Name)
+	d.Set("description", flavor.Description)
+	d.Set("flavor_profile_id", flavor.FlavorProfileID)
+	d.Set("enabled", flavor.Enabled)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
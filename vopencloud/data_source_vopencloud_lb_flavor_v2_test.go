@@ -0,0 +1,47 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLBFlavorV2DataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_lb_flavor_v2.flavor_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBFlavorV2Basic,
+			},
+			{
+				Config: testAccLBFlavorV2DataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"openstack_lb_flavor_v2.flavor_1", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "flavor_profile_id",
+						"openstack_lb_flavorprofile_v2.fp_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLBFlavorV2DataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_lb_flavor_v2" "flavor_1" {
+  name = openstack_lb_flavor_v2.flavor_1.name
+}
+`, testAccLBFlavorV2Basic)
+}
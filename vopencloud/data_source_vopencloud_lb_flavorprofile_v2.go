@@ -0,0 +1,94 @@
+package vopencloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLBFlavorProfileV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBFlavorProfileV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"flavorprofile_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"provider_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			// Computed values
+			"flavor_data": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLBFlavorProfileV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error reading openstack_lb_flavorprofile_v2: Only available when using octavia")
+	}
+
+	listOpts := lbFlavorProfileV2ListOpts{
+		ID:           d.Get("flavorprofile_id").(string),
+		Name:         d.Get("name").(string),
+		ProviderName: d.Get("provider_name").(string),
+	}
+
+	fps, err := lbFlavorProfileV2List(lbClient, listOpts)
+	if err != nil {
+		return diag.Errorf("Unable to query openstack_lb_flavorprofile_v2: %s", err)
+	}
+
+	if len(fps) < 1 {
+		return diag.Errorf("Your openstack_lb_flavorprofile_v2 query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(fps) > 1 {
+		log.Printf("[DEBUG] Multiple openstack_lb_flavorprofile_v2 results found: %#v", fps)
+		return diag.Errorf("Your openstack_lb_flavorprofile_v2 query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	fp := fps[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_flavorprofile_v2 %s: %#v", fp.ID, fp)
+
+	d.SetId(fp.ID)
+	d.Set("flavorprofile_id", fp.ID)
+	d.Set("name", fp.Name)
+	d.Set("provider_name", fp.ProviderName)
+	d.Set("flavor_data", normalizeLBV2JSONData(fp.FlavorData))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLBFlavorProfileV2DataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_lb_flavorprofile_v2.fp_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBFlavorProfileV2Basic,
+			},
+			{
+				Config: testAccLBFlavorProfileV2DataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"openstack_lb_flavorprofile_v2.fp_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "provider_name", "amphora"),
+					resource.TestCheckResourceAttr(resourceName, "flavor_data", `{"loadbalancer_topology":"SINGLE"}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccLBFlavorProfileV2DataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_lb_flavorprofile_v2" "fp_1" {
+  name = openstack_lb_flavorprofile_v2.fp_1.name
+}
+`, testAccLBFlavorProfileV2Basic)
+}
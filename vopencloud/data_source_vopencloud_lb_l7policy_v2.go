@@ -0,0 +1,240 @@
+package vopencloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	octavial7policies "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/l7policies"
+	neutronl7policies "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/l7policies"
+)
+
+func dataSourceLBL7PolicyV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBL7PolicyV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"l7policy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"listener_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"position": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"action": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"REDIRECT_TO_POOL", "REDIRECT_TO_URL", "REDIRECT_PREFIX", "REJECT",
+				}, false),
+			},
+
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			// Computed values
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"redirect_pool_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"redirect_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"redirect_prefix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"redirect_http_code": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"provisioning_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"operating_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"rule_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceLBL7PolicyV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	if config.UseOctavia {
+		listOpts := octavial7policies.ListOpts{
+			ID:         d.Get("l7policy_id").(string),
+			Name:       d.Get("name").(string),
+			ListenerID: d.Get("listener_id").(string),
+			Position:   int32(d.Get("position").(int)),
+			Action:     d.Get("action").(string),
+			ProjectID:  d.Get("tenant_id").(string),
+		}
+
+		allPages, err := octavial7policies.List(lbClient, listOpts).AllPages()
+		if err != nil {
+			return diag.Errorf("Unable to query openstack_lb_l7policy_v2: %s", err)
+		}
+
+		l7Policies, err := octavial7policies.ExtractL7Policies(allPages)
+		if err != nil {
+			return diag.Errorf("Unable to retrieve openstack_lb_l7policy_v2: %s", err)
+		}
+
+		if len(l7Policies) < 1 {
+			return diag.Errorf("Your openstack_lb_l7policy_v2 query returned no results. " +
+				"Please change your search criteria and try again.")
+		}
+
+		if len(l7Policies) > 1 {
+			log.Printf("[DEBUG] Multiple openstack_lb_l7policy_v2 results found: %#v", l7Policies)
+			return diag.Errorf("Your openstack_lb_l7policy_v2 query returned more than one result. " +
+				"Please try a more specific search criteria.")
+		}
+
+		l7Policy := l7Policies[0]
+
+		log.Printf("[DEBUG][Octavia] Retrieved openstack_lb_l7policy_v2 %s: %#v", l7Policy.ID, l7Policy)
+
+		ruleIDs := make([]string, len(l7Policy.Rules))
+		for i, rule := range l7Policy.Rules {
+			ruleIDs[i] = rule.ID
+		}
+
+		d.SetId(l7Policy.ID)
+		d.Set("l7policy_id", l7Policy.ID)
+		d.Set("name", l7Policy.Name)
+		d.Set("listener_id", l7Policy.ListenerID)
+		d.Set("position", int(l7Policy.Position))
+		d.Set("action", l7Policy.Action)
+		d.Set("tenant_id", l7Policy.ProjectID)
+		d.Set("description", l7Policy.Description)
+		d.Set("redirect_pool_id", l7Policy.RedirectPoolID)
+		d.Set("redirect_url", l7Policy.RedirectURL)
+		d.Set("redirect_prefix", l7Policy.RedirectPrefix)
+		d.Set("redirect_http_code", int(l7Policy.RedirectHttpCode))
+		d.Set("admin_state_up", l7Policy.AdminStateUp)
+		d.Set("provisioning_status", l7Policy.ProvisioningStatus)
+		d.Set("operating_status", l7Policy.OperatingStatus)
+		d.Set("rule_ids", ruleIDs)
+		d.Set("region", GetRegion(d, config))
+
+		return nil
+	}
+
+	listOpts := neutronl7policies.ListOpts{
+		ID:         d.Get("l7policy_id").(string),
+		Name:       d.Get("name").(string),
+		ListenerID: d.Get("listener_id").(string),
+		Position:   int32(d.Get("position").(int)),
+		Action:     d.Get("action").(string),
+		TenantID:   d.Get("tenant_id").(string),
+	}
+
+	allPages, err := neutronl7policies.List(lbClient, listOpts).AllPages()
+	if err != nil {
+		return diag.Errorf("Unable to query openstack_lb_l7policy_v2: %s", err)
+	}
+
+	l7Policies, err := neutronl7policies.ExtractL7Policies(allPages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_lb_l7policy_v2: %s", err)
+	}
+
+	if len(l7Policies) < 1 {
+		return diag.Errorf("Your openstack_lb_l7policy_v2 query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(l7Policies) > 1 {
+		log.Printf("[DEBUG] Multiple openstack_lb_l7policy_v2 results found: %#v", l7Policies)
+		return diag.Errorf("Your openstack_lb_l7policy_v2 query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	l7Policy := l7Policies[0]
+
+	log.Printf("[DEBUG][Neutron] Retrieved openstack_lb_l7policy_v2 %s: %#v", l7Policy.ID, l7Policy)
+
+	ruleIDs := make([]string, len(l7Policy.Rules))
+	for i, rule := range l7Policy.Rules {
+		ruleIDs[i] = rule.ID
+	}
+
+	d.SetId(l7Policy.ID)
+	d.Set("l7policy_id", l7Policy.ID)
+	d.Set("name", l7Policy.Name)
+	d.Set("listener_id", l7Policy.ListenerID)
+	d.Set("position", int(l7Policy.Position))
+	d.Set("action", l7Policy.Action)
+	d.Set("tenant_id", l7Policy.TenantID)
+	d.Set("description", l7Policy.Description)
+	d.Set("redirect_pool_id", l7Policy.RedirectPoolID)
+	d.Set("redirect_url", l7Policy.RedirectURL)
+	d.Set("admin_state_up", l7Policy.AdminStateUp)
+	d.Set("provisioning_status", l7Policy.ProvisioningStatus)
+	d.Set("operating_status", l7Policy.OperatingStatus)
+	d.Set("rule_ids", ruleIDs)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
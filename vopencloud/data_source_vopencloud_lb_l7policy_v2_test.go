@@ -0,0 +1,50 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLBV2L7PolicyDataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_lb_l7policy_v2.l7policy_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLbV2L7RuleConfigBasic(),
+			},
+			{
+				Config: testAccLbV2L7PolicyDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"openstack_lb_l7policy_v2.l7policy_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "test"),
+					resource.TestCheckResourceAttr(resourceName, "action", "REDIRECT_TO_URL"),
+					resource.TestCheckResourceAttr(resourceName, "redirect_url", "http://www.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "rule_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "rule_ids.0",
+						"openstack_lb_l7rule_v2.l7rule_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLbV2L7PolicyDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_lb_l7policy_v2" "l7policy_1" {
+  listener_id = openstack_lb_listener_v2.listener_1.id
+  position    = 1
+}
+`, testAccCheckLbV2L7RuleConfigBasic())
+}
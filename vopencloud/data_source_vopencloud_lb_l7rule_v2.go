@@ -0,0 +1,205 @@
+package vopencloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	octavial7policies "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/l7policies"
+	neutronl7policies "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/l7policies"
+)
+
+func dataSourceLBL7RuleV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBL7RuleV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"l7policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"l7rule_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"COOKIE", "FILE_TYPE", "HEADER", "HOST_NAME", "PATH",
+				}, false),
+			},
+
+			"compare_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"CONTAINS", "STARTS_WITH", "ENDS_WITH", "EQUAL_TO", "REGEX",
+				}, false),
+			},
+
+			"value": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			// Computed values
+			"invert": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"provisioning_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"operating_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLBL7RuleV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	l7PolicyID := d.Get("l7policy_id").(string)
+
+	if config.UseOctavia {
+		listOpts := octavial7policies.ListRulesOpts{
+			ID:          d.Get("l7rule_id").(string),
+			RuleType:    octavial7policies.RuleType(d.Get("type").(string)),
+			CompareType: octavial7policies.CompareType(d.Get("compare_type").(string)),
+			Value:       d.Get("value").(string),
+			Key:         d.Get("key").(string),
+			ProjectID:   d.Get("tenant_id").(string),
+		}
+
+		allPages, err := octavial7policies.ListRules(lbClient, l7PolicyID, listOpts).AllPages()
+		if err != nil {
+			return diag.Errorf("Unable to query openstack_lb_l7rule_v2: %s", err)
+		}
+
+		l7Rules, err := octavial7policies.ExtractRules(allPages)
+		if err != nil {
+			return diag.Errorf("Unable to retrieve openstack_lb_l7rule_v2: %s", err)
+		}
+
+		if len(l7Rules) < 1 {
+			return diag.Errorf("Your openstack_lb_l7rule_v2 query returned no results. " +
+				"Please change your search criteria and try again.")
+		}
+
+		if len(l7Rules) > 1 {
+			log.Printf("[DEBUG] Multiple openstack_lb_l7rule_v2 results found: %#v", l7Rules)
+			return diag.Errorf("Your openstack_lb_l7rule_v2 query returned more than one result. " +
+				"Please try a more specific search criteria.")
+		}
+
+		l7Rule := l7Rules[0]
+
+		log.Printf("[DEBUG][Octavia] Retrieved openstack_lb_l7rule_v2 %s: %#v", l7Rule.ID, l7Rule)
+
+		d.SetId(l7Rule.ID)
+		d.Set("l7rule_id", l7Rule.ID)
+		d.Set("type", l7Rule.RuleType)
+		d.Set("compare_type", l7Rule.CompareType)
+		d.Set("value", l7Rule.Value)
+		d.Set("key", l7Rule.Key)
+		d.Set("tenant_id", l7Rule.ProjectID)
+		d.Set("invert", l7Rule.Invert)
+		d.Set("admin_state_up", l7Rule.AdminStateUp)
+		d.Set("provisioning_status", l7Rule.ProvisioningStatus)
+		d.Set("operating_status", l7Rule.OperatingStatus)
+		d.Set("region", GetRegion(d, config))
+
+		return nil
+	}
+
+	listOpts := neutronl7policies.ListRulesOpts{
+		ID:          d.Get("l7rule_id").(string),
+		RuleType:    neutronl7policies.RuleType(d.Get("type").(string)),
+		CompareType: neutronl7policies.CompareType(d.Get("compare_type").(string)),
+		Value:       d.Get("value").(string),
+		Key:         d.Get("key").(string),
+		TenantID:    d.Get("tenant_id").(string),
+	}
+
+	allPages, err := neutronl7policies.ListRules(lbClient, l7PolicyID, listOpts).AllPages()
+	if err != nil {
+		return diag.Errorf("Unable to query openstack_lb_l7rule_v2: %s", err)
+	}
+
+	l7Rules, err := neutronl7policies.ExtractRules(allPages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_lb_l7rule_v2: %s", err)
+	}
+
+	if len(l7Rules) < 1 {
+		return diag.Errorf("Your openstack_lb_l7rule_v2 query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(l7Rules) > 1 {
+		log.Printf("[DEBUG] Multiple openstack_lb_l7rule_v2 results found: %#v", l7Rules)
+		return diag.Errorf("Your openstack_lb_l7rule_v2 query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	l7Rule := l7Rules[0]
+
+	log.Printf("[DEBUG][Neutron] Retrieved openstack_lb_l7rule_v2 %s: %#v", l7Rule.ID, l7Rule)
+
+	d.SetId(l7Rule.ID)
+	d.Set("l7rule_id", l7Rule.ID)
+	d.Set("type", l7Rule.RuleType)
+	d.Set("compare_type", l7Rule.CompareType)
+	d.Set("value", l7Rule.Value)
+	d.Set("key", l7Rule.Key)
+	d.Set("tenant_id", l7Rule.TenantID)
+	d.Set("invert", l7Rule.Invert)
+	d.Set("admin_state_up", l7Rule.AdminStateUp)
+	d.Set("provisioning_status", l7Rule.ProvisioningStatus)
+	d.Set("operating_status", l7Rule.OperatingStatus)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLBV2L7RuleDataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_lb_l7rule_v2.l7rule_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLbV2L7RuleConfigBasic(),
+			},
+			{
+				Config: testAccLbV2L7RuleDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"openstack_lb_l7rule_v2.l7rule_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "PATH"),
+					resource.TestCheckResourceAttr(resourceName, "compare_type", "EQUAL_TO"),
+					resource.TestCheckResourceAttr(resourceName, "value", "/api"),
+					resource.TestCheckResourceAttr(resourceName, "invert", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLbV2L7RuleDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_lb_l7rule_v2" "l7rule_1" {
+  l7policy_id = openstack_lb_l7policy_v2.l7policy_1.id
+  type        = "PATH"
+}
+`, testAccCheckLbV2L7RuleConfigBasic())
+}
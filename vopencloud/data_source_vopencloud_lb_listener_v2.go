@@ -0,0 +1,276 @@
+package vopencloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	octavialisteners "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/listeners"
+	neutronlisteners "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/listeners"
+)
+
+func dataSourceLBListenerV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBListenerV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"listener_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"protocol_port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			// Computed values
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"default_pool_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"connection_limit": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"timeout_client_data": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"timeout_member_connect": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"timeout_member_data": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"timeout_tcp_inspect": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"default_tls_container_ref": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"sni_container_refs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"insert_headers": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"allowed_cidrs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"all_tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceLBListenerV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	tags := expandObjectTags(d)
+
+	if config.UseOctavia {
+		listOpts := octavialisteners.ListOpts{
+			ID:             d.Get("listener_id").(string),
+			Name:           d.Get("name").(string),
+			LoadbalancerID: d.Get("loadbalancer_id").(string),
+			Protocol:       d.Get("protocol").(string),
+			ProtocolPort:   d.Get("protocol_port").(int),
+			ProjectID:      d.Get("tenant_id").(string),
+		}
+
+		allPages, err := octavialisteners.List(lbClient, listOpts).AllPages()
+		if err != nil {
+			return diag.Errorf("Unable to query openstack_lb_listener_v2: %s", err)
+		}
+
+		allListeners, err := octavialisteners.ExtractListeners(allPages)
+		if err != nil {
+			return diag.Errorf("Unable to retrieve openstack_lb_listener_v2: %s", err)
+		}
+
+		var listeners []octavialisteners.Listener
+		for _, listener := range allListeners {
+			if lbV2MatchTags(tags, listener.Tags) {
+				listeners = append(listeners, listener)
+			}
+		}
+
+		if len(listeners) < 1 {
+			return diag.Errorf("Your openstack_lb_listener_v2 query returned no results. " +
+				"Please change your search criteria and try again.")
+		}
+
+		if len(listeners) > 1 {
+			log.Printf("[DEBUG] Multiple openstack_lb_listener_v2 results found: %#v", listeners)
+			return diag.Errorf("Your openstack_lb_listener_v2 query returned more than one result. " +
+				"Please try a more specific search criteria.")
+		}
+
+		listener := listeners[0]
+
+		log.Printf("[DEBUG][Octavia] Retrieved openstack_lb_listener_v2 %s: %#v", listener.ID, listener)
+
+		d.SetId(listener.ID)
+		d.Set("listener_id", listener.ID)
+		d.Set("name", listener.Name)
+		d.Set("protocol", listener.Protocol)
+		d.Set("protocol_port", listener.ProtocolPort)
+		d.Set("tenant_id", listener.ProjectID)
+		d.Set("description", listener.Description)
+		d.Set("default_pool_id", listener.DefaultPoolID)
+		d.Set("admin_state_up", listener.AdminStateUp)
+		d.Set("connection_limit", listener.ConnLimit)
+		d.Set("timeout_client_data", listener.TimeoutClientData)
+		d.Set("timeout_member_connect", listener.TimeoutMemberConnect)
+		d.Set("timeout_member_data", listener.TimeoutMemberData)
+		d.Set("timeout_tcp_inspect", listener.TimeoutTCPInspect)
+		d.Set("default_tls_container_ref", listener.DefaultTlsContainerRef)
+		d.Set("sni_container_refs", listener.SniContainerRefs)
+		d.Set("insert_headers", listener.InsertHeaders)
+		d.Set("allowed_cidrs", listener.AllowedCIDRs)
+		d.Set("all_tags", listener.Tags)
+		d.Set("region", GetRegion(d, config))
+
+		if len(listener.Loadbalancers) > 0 {
+			d.Set("loadbalancer_id", listener.Loadbalancers[0].ID)
+		}
+
+		return nil
+	}
+
+	if len(tags) > 0 {
+		return diag.Errorf("Filtering openstack_lb_listener_v2 by tags requires Octavia")
+	}
+
+	listOpts := neutronlisteners.ListOpts{
+		ID:             d.Get("listener_id").(string),
+		Name:           d.Get("name").(string),
+		LoadbalancerID: d.Get("loadbalancer_id").(string),
+		Protocol:       d.Get("protocol").(string),
+		ProtocolPort:   d.Get("protocol_port").(int),
+		TenantID:       d.Get("tenant_id").(string),
+	}
+
+	allPages, err := neutronlisteners.List(lbClient, listOpts).AllPages()
+	if err != nil {
+		return diag.Errorf("Unable to query openstack_lb_listener_v2: %s", err)
+	}
+
+	listeners, err := neutronlisteners.ExtractListeners(allPages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_lb_listener_v2: %s", err)
+	}
+
+	if len(listeners) < 1 {
+		return diag.Errorf("Your openstack_lb_listener_v2 query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(listeners) > 1 {
+		log.Printf("[DEBUG] Multiple openstack_lb_listener_v2 results found: %#v", listeners)
+		return diag.Errorf("Your openstack_lb_listener_v2 query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	listener := listeners[0]
+
+	log.Printf("[DEBUG][Neutron] Retrieved openstack_lb_listener_v2 %s: %#v", listener.ID, listener)
+
+	d.SetId(listener.ID)
+	d.Set("listener_id", listener.ID)
+	d.Set("name", listener.Name)
+	d.Set("protocol", listener.Protocol)
+	d.Set("protocol_port", listener.ProtocolPort)
+	d.Set("tenant_id", listener.TenantID)
+	d.Set("description", listener.Description)
+	d.Set("default_pool_id", listener.DefaultPoolID)
+	d.Set("admin_state_up", listener.AdminStateUp)
+	d.Set("connection_limit", listener.ConnLimit)
+	d.Set("default_tls_container_ref", listener.DefaultTlsContainerRef)
+	d.Set("sni_container_refs", listener.SniContainerRefs)
+	d.Set("region", GetRegion(d, config))
+
+	if len(listener.Loadbalancers) > 0 {
+		d.Set("loadbalancer_id", listener.Loadbalancers[0].ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLBV2ListenerDataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_lb_listener_v2.listener_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2DataSourceBase,
+			},
+			{
+				Config: testAccLbV2ListenerDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"openstack_lb_listener_v2.listener_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "HTTP"),
+					resource.TestCheckResourceAttr(resourceName, "protocol_port", "8080"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_pool_id",
+						"openstack_lb_pool_v2.pool_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLbV2ListenerDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_lb_listener_v2" "listener_1" {
+  name            = "listener_1"
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+`, testAccLbV2DataSourceBase)
+}
@@ -0,0 +1,56 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLBV2LoadBalancerStatusDataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_lb_loadbalancer_status_v2.status_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2DataSourceBase,
+			},
+			{
+				Config: testAccLbV2LoadBalancerStatusDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "loadbalancer_1"),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_status", "ACTIVE"),
+					resource.TestCheckResourceAttrPair(resourceName, "listeners.0.id",
+						"openstack_lb_listener_v2.listener_1", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "listeners.0.pools.0.id",
+						"openstack_lb_pool_v2.pool_1", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "listeners.0.pools.0.members.0.id",
+						"openstack_lb_member_v2.member_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "listeners.0.pools.0.members.0.address", "192.168.199.110"),
+					resource.TestCheckResourceAttrPair(resourceName, "listeners.0.pools.0.health_monitor.0.id",
+						"openstack_lb_monitor_v2.monitor_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "listeners.0.pools.0.health_monitor.0.type", "PING"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLbV2LoadBalancerStatusDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_lb_loadbalancer_status_v2" "status_1" {
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+`, testAccLbV2DataSourceBase)
+}
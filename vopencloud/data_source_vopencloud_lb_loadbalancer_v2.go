@@ -0,0 +1,240 @@
+package vopencloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	octavialoadbalancers "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
+	neutronloadbalancers "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/loadbalancers"
+)
+
+func dataSourceLBLoadBalancerV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBLoadBalancerV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"vip_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"vip_subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"vip_network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			// Computed values
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"vip_port_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"flavor_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"loadbalancer_provider": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"provisioning_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"operating_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"all_tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceLBLoadBalancerV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	tags := expandObjectTags(d)
+
+	if config.UseOctavia {
+		listOpts := octavialoadbalancers.ListOpts{
+			ID:           d.Get("loadbalancer_id").(string),
+			Name:         d.Get("name").(string),
+			VipAddress:   d.Get("vip_address").(string),
+			VipSubnetID:  d.Get("vip_subnet_id").(string),
+			VipNetworkID: d.Get("vip_network_id").(string),
+			ProjectID:    d.Get("tenant_id").(string),
+			Tags:         tags,
+		}
+
+		allPages, err := octavialoadbalancers.List(lbClient, listOpts).AllPages()
+		if err != nil {
+			return diag.Errorf("Unable to query openstack_lb_loadbalancer_v2: %s", err)
+		}
+
+		allLBs, err := octavialoadbalancers.ExtractLoadBalancers(allPages)
+		if err != nil {
+			return diag.Errorf("Unable to retrieve openstack_lb_loadbalancer_v2: %s", err)
+		}
+
+		if len(allLBs) < 1 {
+			return diag.Errorf("Your openstack_lb_loadbalancer_v2 query returned no results. " +
+				"Please change your search criteria and try again.")
+		}
+
+		if len(allLBs) > 1 {
+			log.Printf("[DEBUG] Multiple openstack_lb_loadbalancer_v2 results found: %#v", allLBs)
+			return diag.Errorf("Your openstack_lb_loadbalancer_v2 query returned more than one result. " +
+				"Please try a more specific search criteria.")
+		}
+
+		lb := allLBs[0]
+
+		log.Printf("[DEBUG][Octavia] Retrieved openstack_lb_loadbalancer_v2 %s: %#v", lb.ID, lb)
+
+		d.SetId(lb.ID)
+		d.Set("loadbalancer_id", lb.ID)
+		d.Set("name", lb.Name)
+		d.Set("description", lb.Description)
+		d.Set("vip_address", lb.VipAddress)
+		d.Set("vip_subnet_id", lb.VipSubnetID)
+		d.Set("vip_network_id", lb.VipNetworkID)
+		d.Set("vip_port_id", lb.VipPortID)
+		d.Set("tenant_id", lb.ProjectID)
+		d.Set("admin_state_up", lb.AdminStateUp)
+		d.Set("flavor_id", lb.FlavorID)
+		d.Set("loadbalancer_provider", lb.Provider)
+		d.Set("availability_zone", lb.AvailabilityZone)
+		d.Set("provisioning_status", lb.ProvisioningStatus)
+		d.Set("operating_status", lb.OperatingStatus)
+		d.Set("all_tags", lb.Tags)
+		d.Set("region", GetRegion(d, config))
+
+		return nil
+	}
+
+	if len(tags) > 0 {
+		return diag.Errorf("Filtering openstack_lb_loadbalancer_v2 by tags requires Octavia")
+	}
+
+	if _, ok := d.GetOk("vip_network_id"); ok {
+		return diag.Errorf("Filtering openstack_lb_loadbalancer_v2 by vip_network_id requires Octavia")
+	}
+
+	listOpts := neutronloadbalancers.ListOpts{
+		ID:          d.Get("loadbalancer_id").(string),
+		Name:        d.Get("name").(string),
+		VipAddress:  d.Get("vip_address").(string),
+		VipSubnetID: d.Get("vip_subnet_id").(string),
+		TenantID:    d.Get("tenant_id").(string),
+	}
+
+	allPages, err := neutronloadbalancers.List(lbClient, listOpts).AllPages()
+	if err != nil {
+		return diag.Errorf("Unable to query openstack_lb_loadbalancer_v2: %s", err)
+	}
+
+	allLBs, err := neutronloadbalancers.ExtractLoadBalancers(allPages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_lb_loadbalancer_v2: %s", err)
+	}
+
+	if len(allLBs) < 1 {
+		return diag.Errorf("Your openstack_lb_loadbalancer_v2 query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allLBs) > 1 {
+		log.Printf("[DEBUG] Multiple openstack_lb_loadbalancer_v2 results found: %#v", allLBs)
+		return diag.Errorf("Your openstack_lb_loadbalancer_v2 query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	lb := allLBs[0]
+
+	log.Printf("[DEBUG][Neutron] Retrieved openstack_lb_loadbalancer_v2 %s: %#v", lb.ID, lb)
+
+	d.SetId(lb.ID)
+	d.Set("loadbalancer_id", lb.ID)
+	d.Set("name", lb.Name)
+	d.Set("description", lb.Description)
+	d.Set("vip_address", lb.VipAddress)
+	d.Set("vip_subnet_id", lb.VipSubnetID)
+	d.Set("vip_port_id", lb.VipPortID)
+	d.Set("tenant_id", lb.TenantID)
+	d.Set("admin_state_up", lb.AdminStateUp)
+	d.Set("flavor_id", lb.FlavorID)
+	d.Set("loadbalancer_provider", lb.Provider)
+	d.Set("provisioning_status", lb.ProvisioningStatus)
+	d.Set("operating_status", lb.OperatingStatus)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLBV2LoadBalancerDataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_lb_loadbalancer_v2.lb_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2DataSourceBase,
+			},
+			{
+				Config: testAccLbV2LoadBalancerDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "loadbalancer_1"),
+					resource.TestCheckResourceAttr(resourceName, "vip_address", "192.168.199.10"),
+					resource.TestCheckResourceAttrPair(resourceName, "vip_subnet_id",
+						"openstack_networking_subnet_v2.subnet_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLBV2LoadBalancerDataSource_tags(t *testing.T) {
+	resourceName := "data.openstack_lb_loadbalancer_v2.lb_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2DataSourceBase,
+			},
+			{
+				Config: testAccLbV2LoadBalancerDataSourceTags(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "all_tags.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccLbV2DataSourceBase = `
+resource "openstack_networking_network_v2" "network_1" {
+  name           = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name       = "subnet_1"
+  network_id = openstack_networking_network_v2.network_1.id
+  cidr       = "192.168.199.0/24"
+  ip_version = 4
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name          = "loadbalancer_1"
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+  vip_address   = "192.168.199.10"
+  tags          = ["tag1", "tag2"]
+
+  timeouts {
+    create = "15m"
+    update = "15m"
+    delete = "15m"
+  }
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  name            = "listener_1"
+  protocol        = "HTTP"
+  protocol_port   = 8080
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  name        = "pool_1"
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = openstack_lb_listener_v2.listener_1.id
+}
+
+resource "openstack_lb_member_v2" "member_1" {
+  address       = "192.168.199.110"
+  protocol_port = 8080
+  pool_id       = openstack_lb_pool_v2.pool_1.id
+  subnet_id     = openstack_networking_subnet_v2.subnet_1.id
+}
+
+resource "openstack_lb_monitor_v2" "monitor_1" {
+  name        = "monitor_1"
+  type        = "PING"
+  delay       = 20
+  timeout     = 10
+  max_retries = 5
+  pool_id     = openstack_lb_pool_v2.pool_1.id
+}
+`
+
+func testAccLbV2LoadBalancerDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_lb_loadbalancer_v2" "lb_1" {
+  vip_address = openstack_lb_loadbalancer_v2.loadbalancer_1.vip_address
+}
+`, testAccLbV2DataSourceBase)
+}
+
+func testAccLbV2LoadBalancerDataSourceTags() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_lb_loadbalancer_v2" "lb_1" {
+  name = "loadbalancer_1"
+  tags = ["tag1"]
+}
+`, testAccLbV2DataSourceBase)
+}
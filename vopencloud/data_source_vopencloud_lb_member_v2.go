@@ -0,0 +1,238 @@
+package vopencloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	octaviapools "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/pools"
+	neutronpools "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+)
+
+func dataSourceLBMemberV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBMemberV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"member_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"protocol_port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			// Computed values
+			"weight": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"monitor_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"monitor_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"backup": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"provisioning_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"operating_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"all_tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceLBMemberV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	poolID := d.Get("pool_id").(string)
+	tags := expandObjectTags(d)
+
+	if config.UseOctavia {
+		listOpts := octaviapools.ListMembersOpts{
+			ID:           d.Get("member_id").(string),
+			Name:         d.Get("name").(string),
+			Address:      d.Get("address").(string),
+			ProtocolPort: d.Get("protocol_port").(int),
+			ProjectID:    d.Get("tenant_id").(string),
+		}
+
+		allPages, err := octaviapools.ListMembers(lbClient, poolID, listOpts).AllPages()
+		if err != nil {
+			return diag.Errorf("Unable to query openstack_lb_member_v2: %s", err)
+		}
+
+		allMembers, err := octaviapools.ExtractMembers(allPages)
+		if err != nil {
+			return diag.Errorf("Unable to retrieve openstack_lb_member_v2: %s", err)
+		}
+
+		var members []octaviapools.Member
+		for _, member := range allMembers {
+			if lbV2MatchTags(tags, member.Tags) {
+				members = append(members, member)
+			}
+		}
+
+		if len(members) < 1 {
+			return diag.Errorf("Your openstack_lb_member_v2 query returned no results. " +
+				"Please change your search criteria and try again.")
+		}
+
+		if len(members) > 1 {
+			log.Printf("[DEBUG] Multiple openstack_lb_member_v2 results found: %#v", members)
+			return diag.Errorf("Your openstack_lb_member_v2 query returned more than one result. " +
+				"Please try a more specific search criteria.")
+		}
+
+		member := members[0]
+
+		log.Printf("[DEBUG][Octavia] Retrieved openstack_lb_member_v2 %s: %#v", member.ID, member)
+
+		d.SetId(member.ID)
+		d.Set("member_id", member.ID)
+		d.Set("name", member.Name)
+		d.Set("address", member.Address)
+		d.Set("protocol_port", member.ProtocolPort)
+		d.Set("tenant_id", member.ProjectID)
+		d.Set("weight", member.Weight)
+		d.Set("subnet_id", member.SubnetID)
+		d.Set("admin_state_up", member.AdminStateUp)
+		d.Set("monitor_address", member.MonitorAddress)
+		d.Set("monitor_port", member.MonitorPort)
+		d.Set("backup", member.Backup)
+		d.Set("provisioning_status", member.ProvisioningStatus)
+		d.Set("operating_status", member.OperatingStatus)
+		d.Set("all_tags", member.Tags)
+		d.Set("region", GetRegion(d, config))
+
+		return nil
+	}
+
+	if len(tags) > 0 {
+		return diag.Errorf("Filtering openstack_lb_member_v2 by tags requires Octavia")
+	}
+
+	listOpts := neutronpools.ListMembersOpts{
+		ID:           d.Get("member_id").(string),
+		Name:         d.Get("name").(string),
+		Address:      d.Get("address").(string),
+		ProtocolPort: d.Get("protocol_port").(int),
+		TenantID:     d.Get("tenant_id").(string),
+	}
+
+	allPages, err := neutronpools.ListMembers(lbClient, poolID, listOpts).AllPages()
+	if err != nil {
+		return diag.Errorf("Unable to query openstack_lb_member_v2: %s", err)
+	}
+
+	members, err := neutronpools.ExtractMembers(allPages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_lb_member_v2: %s", err)
+	}
+
+	if len(members) < 1 {
+		return diag.Errorf("Your openstack_lb_member_v2 query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(members) > 1 {
+		log.Printf("[DEBUG] Multiple openstack_lb_member_v2 results found: %#v", members)
+		return diag.Errorf("Your openstack_lb_member_v2 query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	member := members[0]
+
+	log.Printf("[DEBUG][Neutron] Retrieved openstack_lb_member_v2 %s: %#v", member.ID, member)
+
+	d.SetId(member.ID)
+	d.Set("member_id", member.ID)
+	d.Set("name", member.Name)
+	d.Set("address", member.Address)
+	d.Set("protocol_port", member.ProtocolPort)
+	d.Set("tenant_id", member.TenantID)
+	d.Set("weight", member.Weight)
+	d.Set("subnet_id", member.SubnetID)
+	d.Set("admin_state_up", member.AdminStateUp)
+	d.Set("provisioning_status", member.ProvisioningStatus)
+	d.Set("operating_status", member.OperatingStatus)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLBV2MemberDataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_lb_member_v2.member_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2DataSourceBase,
+			},
+			{
+				Config: testAccLbV2MemberDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"openstack_lb_member_v2.member_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "protocol_port", "8080"),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_id",
+						"openstack_networking_subnet_v2.subnet_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLbV2MemberDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_lb_member_v2" "member_1" {
+  pool_id = openstack_lb_pool_v2.pool_1.id
+  address = openstack_lb_member_v2.member_1.address
+}
+`, testAccLbV2DataSourceBase)
+}
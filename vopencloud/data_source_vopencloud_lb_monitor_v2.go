@@ -0,0 +1,221 @@
+package vopencloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	octaviamonitors "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/monitors"
+	neutronmonitors "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/monitors"
+)
+
+func dataSourceLBMonitorV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBMonitorV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"monitor_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			// Computed values
+			"delay": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"timeout": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"max_retries": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"max_retries_down": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"url_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"http_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"expected_codes": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"provisioning_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLBMonitorV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	if config.UseOctavia {
+		listOpts := octaviamonitors.ListOpts{
+			ID:        d.Get("monitor_id").(string),
+			Name:      d.Get("name").(string),
+			PoolID:    d.Get("pool_id").(string),
+			Type:      d.Get("type").(string),
+			ProjectID: d.Get("tenant_id").(string),
+		}
+
+		allPages, err := octaviamonitors.List(lbClient, listOpts).AllPages()
+		if err != nil {
+			return diag.Errorf("Unable to query openstack_lb_monitor_v2: %s", err)
+		}
+
+		monitors, err := octaviamonitors.ExtractMonitors(allPages)
+		if err != nil {
+			return diag.Errorf("Unable to retrieve openstack_lb_monitor_v2: %s", err)
+		}
+
+		if len(monitors) < 1 {
+			return diag.Errorf("Your openstack_lb_monitor_v2 query returned no results. " +
+				"Please change your search criteria and try again.")
+		}
+
+		if len(monitors) > 1 {
+			log.Printf("[DEBUG] Multiple openstack_lb_monitor_v2 results found: %#v", monitors)
+			return diag.Errorf("Your openstack_lb_monitor_v2 query returned more than one result. " +
+				"Please try a more specific search criteria.")
+		}
+
+		monitor := monitors[0]
+
+		log.Printf("[DEBUG][Octavia] Retrieved openstack_lb_monitor_v2 %s: %#v", monitor.ID, monitor)
+
+		d.SetId(monitor.ID)
+		d.Set("monitor_id", monitor.ID)
+		d.Set("name", monitor.Name)
+		d.Set("type", monitor.Type)
+		d.Set("tenant_id", monitor.ProjectID)
+		d.Set("delay", monitor.Delay)
+		d.Set("timeout", monitor.Timeout)
+		d.Set("max_retries", monitor.MaxRetries)
+		d.Set("max_retries_down", monitor.MaxRetriesDown)
+		d.Set("url_path", monitor.URLPath)
+		d.Set("http_method", monitor.HTTPMethod)
+		d.Set("expected_codes", monitor.ExpectedCodes)
+		d.Set("admin_state_up", monitor.AdminStateUp)
+		d.Set("provisioning_status", monitor.ProvisioningStatus)
+		d.Set("region", GetRegion(d, config))
+
+		if len(monitor.Pools) > 0 {
+			d.Set("pool_id", monitor.Pools[0].ID)
+		}
+
+		return nil
+	}
+
+	listOpts := neutronmonitors.ListOpts{
+		ID:       d.Get("monitor_id").(string),
+		Name:     d.Get("name").(string),
+		PoolID:   d.Get("pool_id").(string),
+		Type:     d.Get("type").(string),
+		TenantID: d.Get("tenant_id").(string),
+	}
+
+	allPages, err := neutronmonitors.List(lbClient, listOpts).AllPages()
+	if err != nil {
+		return diag.Errorf("Unable to query openstack_lb_monitor_v2: %s", err)
+	}
+
+	monitors, err := neutronmonitors.ExtractMonitors(allPages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_lb_monitor_v2: %s", err)
+	}
+
+	if len(monitors) < 1 {
+		return diag.Errorf("Your openstack_lb_monitor_v2 query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(monitors) > 1 {
+		log.Printf("[DEBUG] Multiple openstack_lb_monitor_v2 results found: %#v", monitors)
+		return diag.Errorf("Your openstack_lb_monitor_v2 query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	monitor := monitors[0]
+
+	log.Printf("[DEBUG][Neutron] Retrieved openstack_lb_monitor_v2 %s: %#v", monitor.ID, monitor)
+
+	d.SetId(monitor.ID)
+	d.Set("monitor_id", monitor.ID)
+	d.Set("name", monitor.Name)
+	d.Set("type", monitor.Type)
+	d.Set("tenant_id", monitor.TenantID)
+	d.Set("delay", monitor.Delay)
+	d.Set("timeout", monitor.Timeout)
+	d.Set("max_retries", monitor.MaxRetries)
+	d.Set("url_path", monitor.URLPath)
+	d.Set("http_method", monitor.HTTPMethod)
+	d.Set("expected_codes", monitor.ExpectedCodes)
+	d.Set("admin_state_up", monitor.AdminStateUp)
+	d.Set("provisioning_status", monitor.ProvisioningStatus)
+	d.Set("region", GetRegion(d, config))
+
+	if len(monitor.Pools) > 0 {
+		d.Set("pool_id", monitor.Pools[0].ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLBV2MonitorDataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_lb_monitor_v2.monitor_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2DataSourceBase,
+			},
+			{
+				Config: testAccLbV2MonitorDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"openstack_lb_monitor_v2.monitor_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "PING"),
+					resource.TestCheckResourceAttr(resourceName, "delay", "20"),
+					resource.TestCheckResourceAttrPair(resourceName, "pool_id",
+						"openstack_lb_pool_v2.pool_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLbV2MonitorDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_lb_monitor_v2" "monitor_1" {
+  pool_id = openstack_lb_pool_v2.pool_1.id
+}
+`, testAccLbV2DataSourceBase)
+}
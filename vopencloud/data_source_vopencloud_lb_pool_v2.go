@@ -0,0 +1,282 @@
+package vopencloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	octaviapools "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/pools"
+	neutronpools "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+)
+
+func dataSourceLBPoolV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBPoolV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"listener_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"lb_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			// Computed values
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"monitor_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"persistence": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cookie_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"provisioning_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"operating_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"all_tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceLBPoolV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	tags := expandObjectTags(d)
+	listenerID := d.Get("listener_id").(string)
+
+	if config.UseOctavia {
+		listOpts := octaviapools.ListOpts{
+			ID:             d.Get("pool_id").(string),
+			Name:           d.Get("name").(string),
+			LoadbalancerID: d.Get("loadbalancer_id").(string),
+			Protocol:       d.Get("protocol").(string),
+			LBMethod:       d.Get("lb_method").(string),
+			ProjectID:      d.Get("tenant_id").(string),
+		}
+
+		allPages, err := octaviapools.List(lbClient, listOpts).AllPages()
+		if err != nil {
+			return diag.Errorf("Unable to query openstack_lb_pool_v2: %s", err)
+		}
+
+		allPools, err := octaviapools.ExtractPools(allPages)
+		if err != nil {
+			return diag.Errorf("Unable to retrieve openstack_lb_pool_v2: %s", err)
+		}
+
+		// Octavia doesn't support filtering pools by listener and tags.
+		var pools []octaviapools.Pool
+		for _, pool := range allPools {
+			if listenerID != "" && !dataSourceLBPoolV2OctaviaHasListener(pool, listenerID) {
+				continue
+			}
+			if lbV2MatchTags(tags, pool.Tags) {
+				pools = append(pools, pool)
+			}
+		}
+
+		if len(pools) < 1 {
+			return diag.Errorf("Your openstack_lb_pool_v2 query returned no results. " +
+				"Please change your search criteria and try again.")
+		}
+
+		if len(pools) > 1 {
+			log.Printf("[DEBUG] Multiple openstack_lb_pool_v2 results found: %#v", pools)
+			return diag.Errorf("Your openstack_lb_pool_v2 query returned more than one result. " +
+				"Please try a more specific search criteria.")
+		}
+
+		pool := pools[0]
+
+		log.Printf("[DEBUG][Octavia] Retrieved openstack_lb_pool_v2 %s: %#v", pool.ID, pool)
+
+		d.SetId(pool.ID)
+		d.Set("pool_id", pool.ID)
+		d.Set("name", pool.Name)
+		d.Set("protocol", pool.Protocol)
+		d.Set("lb_method", pool.LBMethod)
+		d.Set("tenant_id", pool.ProjectID)
+		d.Set("description", pool.Description)
+		d.Set("admin_state_up", pool.AdminStateUp)
+		d.Set("monitor_id", pool.MonitorID)
+		d.Set("provisioning_status", pool.ProvisioningStatus)
+		d.Set("operating_status", pool.OperatingStatus)
+		d.Set("all_tags", pool.Tags)
+		d.Set("region", GetRegion(d, config))
+		d.Set("persistence", flattenLBPoolPersistenceV2(neutronpools.SessionPersistence{
+			Type:       pool.Persistence.Type,
+			CookieName: pool.Persistence.CookieName,
+		}))
+
+		if len(pool.Loadbalancers) > 0 {
+			d.Set("loadbalancer_id", pool.Loadbalancers[0].ID)
+		}
+
+		if len(pool.Listeners) > 0 {
+			d.Set("listener_id", pool.Listeners[0].ID)
+		}
+
+		return nil
+	}
+
+	if len(tags) > 0 {
+		return diag.Errorf("Filtering openstack_lb_pool_v2 by tags requires Octavia")
+	}
+
+	listOpts := neutronpools.ListOpts{
+		ID:             d.Get("pool_id").(string),
+		Name:           d.Get("name").(string),
+		LoadbalancerID: d.Get("loadbalancer_id").(string),
+		ListenerID:     listenerID,
+		Protocol:       d.Get("protocol").(string),
+		LBMethod:       d.Get("lb_method").(string),
+		TenantID:       d.Get("tenant_id").(string),
+	}
+
+	allPages, err := neutronpools.List(lbClient, listOpts).AllPages()
+	if err != nil {
+		return diag.Errorf("Unable to query openstack_lb_pool_v2: %s", err)
+	}
+
+	pools, err := neutronpools.ExtractPools(allPages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_lb_pool_v2: %s", err)
+	}
+
+	if len(pools) < 1 {
+		return diag.Errorf("Your openstack_lb_pool_v2 query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(pools) > 1 {
+		log.Printf("[DEBUG] Multiple openstack_lb_pool_v2 results found: %#v", pools)
+		return diag.Errorf("Your openstack_lb_pool_v2 query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	pool := pools[0]
+
+	log.Printf("[DEBUG][Neutron] Retrieved openstack_lb_pool_v2 %s: %#v", pool.ID, pool)
+
+	d.SetId(pool.ID)
+	d.Set("pool_id", pool.ID)
+	d.Set("name", pool.Name)
+	d.Set("protocol", pool.Protocol)
+	d.Set("lb_method", pool.LBMethod)
+	d.Set("tenant_id", pool.TenantID)
+	d.Set("description", pool.Description)
+	d.Set("admin_state_up", pool.AdminStateUp)
+	d.Set("monitor_id", pool.MonitorID)
+	d.Set("provisioning_status", pool.ProvisioningStatus)
+	d.Set("operating_status", pool.OperatingStatus)
+	d.Set("persistence", flattenLBPoolPersistenceV2(pool.Persistence))
+	d.Set("region", GetRegion(d, config))
+
+	if len(pool.Loadbalancers) > 0 {
+		d.Set("loadbalancer_id", pool.Loadbalancers[0].ID)
+	}
+
+	if len(pool.Listeners) > 0 {
+		d.Set("listener_id", pool.Listeners[0].ID)
+	}
+
+	return nil
+}
+
+func dataSourceLBPoolV2OctaviaHasListener(pool octaviapools.Pool, listenerID string) bool {
+	for _, listener := range pool.Listeners {
+		if listener.ID == listenerID {
+			return true
+		}
+	}
+
+	return false
+}
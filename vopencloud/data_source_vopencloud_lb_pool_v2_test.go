@@ -0,0 +1,48 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLBV2PoolDataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_lb_pool_v2.pool_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2DataSourceBase,
+			},
+			{
+				Config: testAccLbV2PoolDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"openstack_lb_pool_v2.pool_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "lb_method", "ROUND_ROBIN"),
+					resource.TestCheckResourceAttrPair(resourceName, "loadbalancer_id",
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "monitor_id",
+						"openstack_lb_monitor_v2.monitor_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLbV2PoolDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_lb_pool_v2" "pool_1" {
+  listener_id = openstack_lb_listener_v2.listener_1.id
+}
+`, testAccLbV2DataSourceBase)
+}
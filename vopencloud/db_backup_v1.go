@@ -0,0 +1,183 @@
+package vopencloud
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/db/v1/instances"
+)
+
+// databaseBackupV1 represents a Trove backup.
+type databaseBackupV1 struct {
+	ID          string                    `json:"id"`
+	Name        string                    `json:"name"`
+	Description string                    `json:"description"`
+	InstanceID  string                    `json:"instance_id"`
+	ParentID    string                    `json:"parent_id"`
+	LocationRef string                    `json:"locationRef"`
+	Size        float64                   `json:"size"`
+	Status      string                    `json:"status"`
+	Datastore   databaseBackupV1Datastore `json:"datastore"`
+	Created     string                    `json:"created"`
+	Updated     string                    `json:"updated"`
+}
+
+// databaseBackupV1Datastore represents the datastore of a Trove backup.
+type databaseBackupV1Datastore struct {
+	Type      string `json:"type"`
+	Version   string `json:"version"`
+	VersionID string `json:"version_id"`
+}
+
+// databaseBackupV1CreateOpts represents the attributes used when creating
+// a new Trove backup.
+type databaseBackupV1CreateOpts struct {
+	Name        string `json:"name" required:"true"`
+	InstanceID  string `json:"instance" required:"true"`
+	Description string `json:"description,omitempty"`
+	ParentID    string `json:"parent_id,omitempty"`
+	Incremental int    `json:"incremental,omitempty"`
+}
+
+// databaseBackupV1ListOpts allows to filter the list of Trove backups.
+type databaseBackupV1ListOpts struct {
+	InstanceID string `q:"instance_id"`
+}
+
+// databaseInstanceV1CreateOptsExt allows to restore a new database instance
+// from a Trove backup or to create it as a replica of another instance.
+type databaseInstanceV1CreateOptsExt struct {
+	instances.CreateOptsBuilder
+	RestorePoint string
+	ReplicaOf    string
+}
+
+// ToInstanceCreateMap adds the restorePoint and replica_of to the base
+// create options.
+func (opts databaseInstanceV1CreateOptsExt) ToInstanceCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToInstanceCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.RestorePoint == "" && opts.ReplicaOf == "" {
+		return base, nil
+	}
+
+	instance, ok := base["instance"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Unable to extend the instance create options")
+	}
+
+	if opts.RestorePoint != "" {
+		instance["restorePoint"] = map[string]interface{}{
+			"backupRef": opts.RestorePoint,
+		}
+	}
+
+	if opts.ReplicaOf != "" {
+		instance["replica_of"] = opts.ReplicaOf
+	}
+
+	return base, nil
+}
+
+func databaseBackupV1Create(client *gophercloud.ServiceClient, opts databaseBackupV1CreateOpts) (*databaseBackupV1, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "backup")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Backup databaseBackupV1 `json:"backup"`
+	}
+	_, err = client.Post(client.ServiceURL("backups"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Backup, nil
+}
+
+func databaseBackupV1Get(client *gophercloud.ServiceClient, id string) (*databaseBackupV1, error) {
+	var r struct {
+		Backup databaseBackupV1 `json:"backup"`
+	}
+	_, err := client.Get(client.ServiceURL("backups", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Backup, nil
+}
+
+func databaseBackupV1List(client *gophercloud.ServiceClient, opts databaseBackupV1ListOpts) ([]databaseBackupV1, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Backups []databaseBackupV1 `json:"backups"`
+	}
+	_, err = client.Get(client.ServiceURL("backups")+q.String(), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Backups, nil
+}
+
+func databaseBackupV1Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("backups", id), &gophercloud.RequestOpts{
+		OkCodes: []int{202, 204},
+	})
+
+	return err
+}
+
+// databaseBackupV1StateRefreshFunc returns a resource.StateRefreshFunc
+// that is used to watch a Trove backup.
+func databaseBackupV1StateRefreshFunc(client *gophercloud.ServiceClient, backupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		b, err := databaseBackupV1Get(client, backupID)
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return b, "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		if b.Status == "FAILED" || b.Status == "DELETE_FAILED" {
+			return b, b.Status, fmt.Errorf("openstack_db_backup_v1 is in %s status", b.Status)
+		}
+
+		return b, b.Status, nil
+	}
+}
+
+func flattenDatabaseBackupV1Datastore(datastore databaseBackupV1Datastore) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"type":    datastore.Type,
+			"version": datastore.Version,
+		},
+	}
+}
+
+// databaseBackupV1MostRecent returns the most recently created backup. The
+// created timestamps share the same format, so they can be compared as
+// strings.
+func databaseBackupV1MostRecent(backups []databaseBackupV1) databaseBackupV1 {
+	sortedBackups := make([]databaseBackupV1, len(backups))
+	copy(sortedBackups, backups)
+	sort.Slice(sortedBackups, func(i, j int) bool {
+		return sortedBackups[i].Created < sortedBackups[j].Created
+	})
+	return sortedBackups[len(sortedBackups)-1]
+}
@@ -0,0 +1,139 @@
+package vopencloud
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gophercloud/gophercloud/openstack/db/v1/instances"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestUnitDatabaseBackupV1Create(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/backups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+{
+  "backup": {
+    "name": "incremental",
+    "instance": "d4603f69-ec7e-4e9b-803f-600b9205576f",
+    "parent_id": "a9832168-7541-4536-b8d9-a8a9b79cf1b4",
+    "incremental": 1
+  }
+}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `
+{
+  "backup": {
+    "id": "3d9b8e4d-3ab5-4b8e-a4e6-0b2b8f6a7cc6",
+    "name": "incremental",
+    "instance_id": "d4603f69-ec7e-4e9b-803f-600b9205576f",
+    "parent_id": "a9832168-7541-4536-b8d9-a8a9b79cf1b4",
+    "status": "NEW",
+    "datastore": {"type": "mysql", "version": "5.7", "version_id": "b00000b0"}
+  }
+}`)
+	})
+
+	backup, err := databaseBackupV1Create(thclient.ServiceClient(), databaseBackupV1CreateOpts{
+		Name:        "incremental",
+		InstanceID:  "d4603f69-ec7e-4e9b-803f-600b9205576f",
+		ParentID:    "a9832168-7541-4536-b8d9-a8a9b79cf1b4",
+		Incremental: 1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "3d9b8e4d-3ab5-4b8e-a4e6-0b2b8f6a7cc6", backup.ID)
+	assert.Equal(t, "a9832168-7541-4536-b8d9-a8a9b79cf1b4", backup.ParentID)
+	assert.Equal(t, "NEW", backup.Status)
+	assert.Equal(t, "mysql", backup.Datastore.Type)
+}
+
+func TestUnitDatabaseBackupV1StateRefreshFunc(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/backups/3d9b8e4d-3ab5-4b8e-a4e6-0b2b8f6a7cc6", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"backup": {"id": "3d9b8e4d-3ab5-4b8e-a4e6-0b2b8f6a7cc6", "status": "FAILED"}}`)
+	})
+
+	_, status, err := databaseBackupV1StateRefreshFunc(thclient.ServiceClient(), "3d9b8e4d-3ab5-4b8e-a4e6-0b2b8f6a7cc6")()
+	assert.Error(t, err)
+	assert.Equal(t, "FAILED", status)
+
+	_, status, err = databaseBackupV1StateRefreshFunc(thclient.ServiceClient(), "deleted")()
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETED", status)
+}
+
+func TestUnitDatabaseBackupV1MostRecent(t *testing.T) {
+	backups := []databaseBackupV1{
+		{ID: "2", Created: "2023-01-02T10:00:00"},
+		{ID: "3", Created: "2023-01-03T10:00:00"},
+		{ID: "1", Created: "2023-01-01T10:00:00"},
+	}
+
+	assert.Equal(t, "3", databaseBackupV1MostRecent(backups).ID)
+	assert.Equal(t, "2", backups[0].ID)
+}
+
+func TestUnitDatabaseInstanceV1CreateOptsExt(t *testing.T) {
+	createOpts := databaseInstanceV1CreateOptsExt{
+		CreateOptsBuilder: &instances.CreateOpts{
+			FlavorRef: "1",
+			Name:      "restored",
+			Size:      10,
+		},
+		RestorePoint: "3d9b8e4d-3ab5-4b8e-a4e6-0b2b8f6a7cc6",
+	}
+
+	b, err := createOpts.ToInstanceCreateMap()
+	assert.NoError(t, err)
+
+	instance := b["instance"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{
+		"backupRef": "3d9b8e4d-3ab5-4b8e-a4e6-0b2b8f6a7cc6",
+	}, instance["restorePoint"])
+	assert.Equal(t, "restored", instance["name"])
+	assert.NotContains(t, instance, "replica_of")
+}
+
+func TestUnitDatabaseInstanceV1CreateOptsExtReplicaOf(t *testing.T) {
+	createOpts := databaseInstanceV1CreateOptsExt{
+		CreateOptsBuilder: &instances.CreateOpts{
+			FlavorRef: "1",
+			Name:      "replica",
+			Size:      10,
+		},
+		ReplicaOf: "d4603f69-ec7e-4e9b-803f-600b9205576f",
+	}
+
+	b, err := createOpts.ToInstanceCreateMap()
+	assert.NoError(t, err)
+
+	instance := b["instance"].(map[string]interface{})
+	assert.Equal(t, "d4603f69-ec7e-4e9b-803f-600b9205576f", instance["replica_of"])
+	assert.NotContains(t, instance, "restorePoint")
+}
+
+func TestUnitExpandDatabaseInstanceV1RestorePoint(t *testing.T) {
+	restorePoint := []interface{}{
+		map[string]interface{}{
+			"backup_ref": "3d9b8e4d-3ab5-4b8e-a4e6-0b2b8f6a7cc6",
+		},
+	}
+
+	assert.Equal(t, "3d9b8e4d-3ab5-4b8e-a4e6-0b2b8f6a7cc6", expandDatabaseInstanceV1RestorePoint(restorePoint))
+	assert.Equal(t, "", expandDatabaseInstanceV1RestorePoint([]interface{}{}))
+}
@@ -0,0 +1,234 @@
+package vopencloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// databaseClusterV1 represents a Trove cluster.
+type databaseClusterV1 struct {
+	ID        string                      `json:"id"`
+	Name      string                      `json:"name"`
+	Datastore databaseClusterV1Datastore  `json:"datastore"`
+	Task      databaseClusterV1Task       `json:"task"`
+	Instances []databaseClusterV1Instance `json:"instances"`
+	IP        []string                    `json:"ip"`
+	Created   string                      `json:"created"`
+	Updated   string                      `json:"updated"`
+}
+
+// databaseClusterV1Datastore represents the datastore of a Trove cluster.
+type databaseClusterV1Datastore struct {
+	Type    string `json:"type"`
+	Version string `json:"version"`
+}
+
+// databaseClusterV1Task represents the current task of a Trove cluster.
+type databaseClusterV1Task struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// databaseClusterV1Instance represents an instance of a Trove cluster.
+type databaseClusterV1Instance struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Status string `json:"status"`
+	Flavor *struct {
+		ID string `json:"id"`
+	} `json:"flavor"`
+	Volume *struct {
+		Size int `json:"size"`
+	} `json:"volume"`
+}
+
+// databaseClusterV1InstanceOpts represents the attributes used when adding
+// an instance to a Trove cluster.
+type databaseClusterV1InstanceOpts struct {
+	FlavorRef        string                         `json:"flavorRef" required:"true"`
+	Volume           *databaseClusterV1VolumeOpts   `json:"volume,omitempty"`
+	Networks         []databaseClusterV1NetworkOpts `json:"nics,omitempty"`
+	AvailabilityZone string                         `json:"availability_zone,omitempty"`
+}
+
+// databaseClusterV1VolumeOpts represents the volume of a Trove cluster
+// instance.
+type databaseClusterV1VolumeOpts struct {
+	Size int `json:"size"`
+}
+
+// databaseClusterV1NetworkOpts represents a network of a Trove cluster
+// instance.
+type databaseClusterV1NetworkOpts struct {
+	UUID string `json:"net-id"`
+}
+
+// databaseClusterV1CreateOpts represents the attributes used when creating
+// a new Trove cluster.
+type databaseClusterV1CreateOpts struct {
+	Name      string                          `json:"name" required:"true"`
+	Datastore databaseClusterV1Datastore      `json:"datastore" required:"true"`
+	Instances []databaseClusterV1InstanceOpts `json:"instances" required:"true"`
+	Locality  string                          `json:"locality,omitempty"`
+}
+
+func databaseClusterV1Create(client *gophercloud.ServiceClient, opts databaseClusterV1CreateOpts) (*databaseClusterV1, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "cluster")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Cluster databaseClusterV1 `json:"cluster"`
+	}
+	_, err = client.Post(client.ServiceURL("clusters"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Cluster, nil
+}
+
+func databaseClusterV1Get(client *gophercloud.ServiceClient, id string) (*databaseClusterV1, error) {
+	var r struct {
+		Cluster databaseClusterV1 `json:"cluster"`
+	}
+	_, err := client.Get(client.ServiceURL("clusters", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Cluster, nil
+}
+
+// databaseClusterV1Grow adds new instances to a Trove cluster.
+func databaseClusterV1Grow(client *gophercloud.ServiceClient, id string, opts []databaseClusterV1InstanceOpts) error {
+	b := map[string]interface{}{
+		"grow": opts,
+	}
+
+	_, err := client.Post(client.ServiceURL("clusters", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+
+	return err
+}
+
+// databaseClusterV1Shrink removes the given instances from a Trove cluster.
+func databaseClusterV1Shrink(client *gophercloud.ServiceClient, id string, instanceIDs []string) error {
+	instances := make([]map[string]string, 0, len(instanceIDs))
+	for _, instanceID := range instanceIDs {
+		instances = append(instances, map[string]string{"id": instanceID})
+	}
+
+	b := map[string]interface{}{
+		"shrink": instances,
+	}
+
+	_, err := client.Post(client.ServiceURL("clusters", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+
+	return err
+}
+
+func databaseClusterV1Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("clusters", id), &gophercloud.RequestOpts{
+		OkCodes: []int{202, 204},
+	})
+
+	return err
+}
+
+// databaseClusterV1StateRefreshFunc returns a resource.StateRefreshFunc
+// that is used to watch the task of a Trove cluster. An idle cluster has
+// the NONE task.
+func databaseClusterV1StateRefreshFunc(client *gophercloud.ServiceClient, clusterID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		c, err := databaseClusterV1Get(client, clusterID)
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return c, "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		for _, instance := range c.Instances {
+			if instance.Status == "ERROR" || instance.Status == "FAILED" {
+				return c, c.Task.Name, fmt.Errorf("openstack_db_cluster_v1 instance %s is in %s status", instance.ID, instance.Status)
+			}
+		}
+
+		return c, c.Task.Name, nil
+	}
+}
+
+// databaseClusterV1Members returns the data instances of a Trove cluster.
+// Some datastores add extra instances, e.g. query routers and config servers
+// for MongoDB, which are not counted as members.
+func databaseClusterV1Members(cluster *databaseClusterV1) []databaseClusterV1Instance {
+	var members []databaseClusterV1Instance
+	for _, instance := range cluster.Instances {
+		if instance.Type == "" || instance.Type == "member" {
+			members = append(members, instance)
+		}
+	}
+
+	return members
+}
+
+// databaseClusterV1ShrinkIDs returns the IDs of the last members, which are
+// removed when a Trove cluster is scaled down to the given count.
+func databaseClusterV1ShrinkIDs(cluster *databaseClusterV1, count int) []string {
+	members := databaseClusterV1Members(cluster)
+
+	var ids []string
+	for i := len(members) - 1; i >= count && i >= 0; i-- {
+		ids = append(ids, members[i].ID)
+	}
+
+	return ids
+}
+
+func expandDatabaseClusterV1InstanceOpts(flavorID string, size int, rawNetworks []interface{}, availabilityZone string, count int) []databaseClusterV1InstanceOpts {
+	networks := make([]databaseClusterV1NetworkOpts, 0, len(rawNetworks))
+	for _, v := range rawNetworks {
+		network := v.(map[string]interface{})
+		networks = append(networks, databaseClusterV1NetworkOpts{
+			UUID: network["uuid"].(string),
+		})
+	}
+
+	opts := make([]databaseClusterV1InstanceOpts, 0, count)
+	for i := 0; i < count; i++ {
+		opts = append(opts, databaseClusterV1InstanceOpts{
+			FlavorRef:        flavorID,
+			Volume:           &databaseClusterV1VolumeOpts{Size: size},
+			Networks:         networks,
+			AvailabilityZone: availabilityZone,
+		})
+	}
+
+	return opts
+}
+
+func flattenDatabaseClusterV1Instances(instances []databaseClusterV1Instance) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(instances))
+	for _, instance := range instances {
+		res = append(res, map[string]interface{}{
+			"id":     instance.ID,
+			"name":   instance.Name,
+			"type":   instance.Type,
+			"status": instance.Status,
+		})
+	}
+
+	return res
+}
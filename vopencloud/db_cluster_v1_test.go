@@ -0,0 +1,115 @@
+package vopencloud
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestUnitDatabaseClusterV1Create(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/clusters", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+{
+  "cluster": {
+    "name": "galera",
+    "datastore": {"type": "mariadb", "version": "10.4"},
+    "instances": [
+      {"flavorRef": "1", "volume": {"size": 5}, "nics": [{"net-id": "net-1"}]},
+      {"flavorRef": "1", "volume": {"size": 5}, "nics": [{"net-id": "net-1"}]}
+    ],
+    "locality": "anti-affinity"
+  }
+}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `
+{
+  "cluster": {
+    "id": "b6c0f2d5-4b04-4b8b-9c5c-4d6b1a7b3b2c",
+    "name": "galera",
+    "task": {"id": 2, "name": "BUILDING", "description": "Building the initial cluster."}
+  }
+}`)
+	})
+
+	network := []interface{}{
+		map[string]interface{}{"uuid": "net-1"},
+	}
+	cluster, err := databaseClusterV1Create(thclient.ServiceClient(), databaseClusterV1CreateOpts{
+		Name:      "galera",
+		Datastore: databaseClusterV1Datastore{Type: "mariadb", Version: "10.4"},
+		Instances: expandDatabaseClusterV1InstanceOpts("1", 5, network, "", 2),
+		Locality:  "anti-affinity",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "b6c0f2d5-4b04-4b8b-9c5c-4d6b1a7b3b2c", cluster.ID)
+	assert.Equal(t, "BUILDING", cluster.Task.Name)
+}
+
+func TestUnitDatabaseClusterV1Shrink(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/clusters/b6c0f2d5-4b04-4b8b-9c5c-4d6b1a7b3b2c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `{"shrink": [{"id": "member-3"}, {"id": "member-2"}]}`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	cluster := &databaseClusterV1{
+		Instances: []databaseClusterV1Instance{
+			{ID: "member-1", Type: "member"},
+			{ID: "router-1", Type: "query_router"},
+			{ID: "member-2", Type: "member"},
+			{ID: "config-1", Type: "config_server"},
+			{ID: "member-3", Type: "member"},
+		},
+	}
+
+	assert.Len(t, databaseClusterV1Members(cluster), 3)
+
+	ids := databaseClusterV1ShrinkIDs(cluster, 1)
+	assert.Equal(t, []string{"member-3", "member-2"}, ids)
+
+	err := databaseClusterV1Shrink(thclient.ServiceClient(), "b6c0f2d5-4b04-4b8b-9c5c-4d6b1a7b3b2c", ids)
+	assert.NoError(t, err)
+}
+
+func TestUnitDatabaseClusterV1StateRefreshFunc(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/clusters/b6c0f2d5-4b04-4b8b-9c5c-4d6b1a7b3b2c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `
+{
+  "cluster": {
+    "id": "b6c0f2d5-4b04-4b8b-9c5c-4d6b1a7b3b2c",
+    "task": {"id": 1, "name": "NONE"},
+    "instances": [{"id": "member-1", "type": "member", "status": "ERROR"}]
+  }
+}`)
+	})
+
+	_, task, err := databaseClusterV1StateRefreshFunc(thclient.ServiceClient(), "b6c0f2d5-4b04-4b8b-9c5c-4d6b1a7b3b2c")()
+	assert.Error(t, err)
+	assert.Equal(t, "NONE", task)
+
+	_, task, err = databaseClusterV1StateRefreshFunc(thclient.ServiceClient(), "deleted")()
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETED", task)
+}
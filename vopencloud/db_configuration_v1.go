@@ -1,6 +1,7 @@
 package vopencloud
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -43,6 +44,61 @@ func expandDatabaseConfigurationV1Values(rawValues []interface{}) map[string]int
 	return values
 }
 
+func flattenDatabaseConfigurationV1Datastore(cgroup *configurations.Config) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"version": cgroup.DatastoreVersionName,
+			"type":    cgroup.DatastoreName,
+		},
+	}
+}
+
+// flattenDatabaseConfigurationV1Values converts the configuration values
+// returned by the API into the configuration set. A string value, which
+// would be sent as a number or a bool by expandDatabaseConfigurationV1Values,
+// is marked with string_type. The string_type of the current configuration
+// is preserved for the other strings.
+func flattenDatabaseConfigurationV1Values(values map[string]interface{}, currentValues []interface{}) []map[string]interface{} {
+	stringTypes := make(map[string]bool, len(currentValues))
+	for _, rawValue := range currentValues {
+		v := rawValue.(map[string]interface{})
+		if isStringType, ok := v["string_type"].(bool); ok {
+			stringTypes[v["name"].(string)] = isStringType
+		}
+	}
+
+	res := make([]map[string]interface{}, 0, len(values))
+	for name, value := range values {
+		var stringValue string
+		var isStringType bool
+
+		switch v := value.(type) {
+		case string:
+			stringValue = v
+			isStringType = stringTypes[name]
+			if _, err := strconv.Atoi(v); err == nil {
+				isStringType = true
+			} else if _, err := strconv.ParseBool(v); err == nil {
+				isStringType = true
+			}
+		case float64:
+			stringValue = strconv.FormatFloat(v, 'f', -1, 64)
+		case bool:
+			stringValue = strconv.FormatBool(v)
+		default:
+			stringValue = fmt.Sprintf("%v", v)
+		}
+
+		res = append(res, map[string]interface{}{
+			"name":        name,
+			"value":       stringValue,
+			"string_type": isStringType,
+		})
+	}
+
+	return res
+}
+
 // databaseConfigurationV1StateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
 // an cloud database instance.
 func databaseConfigurationV1StateRefreshFunc(client *gophercloud.ServiceClient, cgroupID string) resource.StateRefreshFunc {
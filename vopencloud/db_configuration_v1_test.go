@@ -73,3 +73,46 @@ func TestUnitExpandDatabaseConfigurationV1Values(t *testing.T) {
 	actual := expandDatabaseConfigurationV1Values(values)
 	assert.Equal(t, expected, actual)
 }
+
+func TestUnitFlattenDatabaseConfigurationV1Values(t *testing.T) {
+	values := map[string]interface{}{
+		"collation_server": "latin1_swedish_ci",
+		"max_connections":  float64(200),
+		"autocommit":       true,
+		"connect_timeout":  "3",
+	}
+
+	currentValues := []interface{}{
+		map[string]interface{}{
+			"name":        "collation_server",
+			"value":       "latin1_swedish_ci",
+			"string_type": true,
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"name":        "collation_server",
+			"value":       "latin1_swedish_ci",
+			"string_type": true,
+		},
+		{
+			"name":        "max_connections",
+			"value":       "200",
+			"string_type": false,
+		},
+		{
+			"name":        "autocommit",
+			"value":       "true",
+			"string_type": false,
+		},
+		{
+			"name":        "connect_timeout",
+			"value":       "3",
+			"string_type": true,
+		},
+	}
+
+	actual := flattenDatabaseConfigurationV1Values(values, currentValues)
+	assert.ElementsMatch(t, expected, actual)
+}
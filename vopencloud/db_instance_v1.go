@@ -1,13 +1,17 @@
 package vopencloud
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/db/v1/databases"
+	"github.com/gophercloud/gophercloud/openstack/db/v1/datastores"
 	"github.com/gophercloud/gophercloud/openstack/db/v1/instances"
 	"github.com/gophercloud/gophercloud/openstack/db/v1/users"
 )
@@ -66,6 +70,103 @@ func expandDatabaseInstanceV1Users(rawUsers []interface{}) users.BatchCreateOpts
 	return userList
 }
 
+func expandDatabaseInstanceV1RestorePoint(rawRestorePoint []interface{}) string {
+	if len(rawRestorePoint) == 0 || rawRestorePoint[0] == nil {
+		return ""
+	}
+
+	v := rawRestorePoint[0].(map[string]interface{})
+
+	return v["backup_ref"].(string)
+}
+
+// databaseInstanceV1Details represents the attributes of a database instance,
+// which are missing in instances.Instance.
+type databaseInstanceV1Details struct {
+	Configuration *struct {
+		ID string `json:"id"`
+	} `json:"configuration"`
+	ReplicaOf *struct {
+		ID string `json:"id"`
+	} `json:"replica_of"`
+	Addresses []databaseInstanceV1Address `json:"addresses"`
+}
+
+type databaseInstanceV1Address struct {
+	Network string `json:"network"`
+}
+
+func databaseInstanceV1ExtractDetails(r instances.GetResult) (*databaseInstanceV1Details, error) {
+	var s struct {
+		Instance databaseInstanceV1Details `json:"instance"`
+	}
+	err := r.ExtractInto(&s)
+
+	return &s.Instance, err
+}
+
+func flattenDatabaseInstanceV1Datastore(datastore datastores.DatastorePartial) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"version": datastore.Version,
+			"type":    datastore.Type,
+		},
+	}
+}
+
+// databaseInstanceV1NetworkIDs returns the IDs of the networks, the database
+// instance addresses belong to. Older Trove releases don't return the network
+// of the addresses.
+func databaseInstanceV1NetworkIDs(details *databaseInstanceV1Details) []string {
+	var networkIDs []string
+	for _, address := range details.Addresses {
+		if address.Network != "" && !strSliceContains(networkIDs, address.Network) {
+			networkIDs = append(networkIDs, address.Network)
+		}
+	}
+
+	return networkIDs
+}
+
+// flattenDatabaseInstanceV1Networks returns the networks of a database
+// instance. It's used on import, because the API only returns the network
+// IDs and the ports and fixed IPs can't be read back.
+func flattenDatabaseInstanceV1Networks(details *databaseInstanceV1Details) []map[string]interface{} {
+	networkIDs := databaseInstanceV1NetworkIDs(details)
+	networks := make([]map[string]interface{}, 0, len(networkIDs))
+	for _, networkID := range networkIDs {
+		networks = append(networks, map[string]interface{}{
+			"uuid":        networkID,
+			"port":        "",
+			"fixed_ip_v4": "",
+			"fixed_ip_v6": "",
+		})
+	}
+
+	return networks
+}
+
+// databaseInstanceV1CurrentNetworks returns the current networks of a
+// database instance without the networks, which are no longer attached.
+// Networks set by a port only are kept, because the API doesn't return the
+// ports, and networks, which aren't configured, are not added.
+func databaseInstanceV1CurrentNetworks(details *databaseInstanceV1Details, currentNetworks []interface{}) []interface{} {
+	networkIDs := databaseInstanceV1NetworkIDs(details)
+	if len(networkIDs) == 0 {
+		return currentNetworks
+	}
+
+	networks := make([]interface{}, 0, len(currentNetworks))
+	for _, v := range currentNetworks {
+		network := v.(map[string]interface{})
+		if uuid := network["uuid"].(string); uuid == "" || strSliceContains(networkIDs, uuid) {
+			networks = append(networks, network)
+		}
+	}
+
+	return networks
+}
+
 // databaseInstanceV1StateRefreshFunc returns a resource.StateRefreshFunc
 // that is used to watch a database instance.
 func databaseInstanceV1StateRefreshFunc(client *gophercloud.ServiceClient, instanceID string) resource.StateRefreshFunc {
@@ -97,3 +198,84 @@ func expandInstanceV1UserDatabases(v []interface{}) databases.BatchCreateOpts {
 
 	return dbs
 }
+
+var databaseInstanceV1PendingStates = []string{"BUILD", "RESIZE", "REBOOT", "SHUTDOWN", "PROMOTE", "EJECT", "DETACH"}
+
+// databaseInstanceV1WaitForActive waits for a database instance to finish
+// an action and to become ACTIVE again. An instance, which requires a restart
+// to apply its configuration group, reports RESTART_REQUIRED instead.
+func databaseInstanceV1WaitForActive(ctx context.Context, client *gophercloud.ServiceClient, instanceID string, timeout time.Duration) error {
+	return databaseInstanceV1WaitForState(ctx, client, instanceID, databaseInstanceV1PendingStates,
+		[]string{"ACTIVE", "HEALTHY", "RESTART_REQUIRED"}, timeout)
+}
+
+func databaseInstanceV1WaitForState(ctx context.Context, client *gophercloud.ServiceClient, instanceID string, pending, target []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     target,
+		Refresh:    databaseInstanceV1StateRefreshFunc(client, instanceID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+// databaseInstanceV1RestartIfRequired restarts a database instance, which
+// requires a restart to apply its configuration group.
+func databaseInstanceV1RestartIfRequired(ctx context.Context, client *gophercloud.ServiceClient, instanceID string, timeout time.Duration) error {
+	instance, err := instances.Get(client, instanceID).Extract()
+	if err != nil {
+		return err
+	}
+
+	if instance.Status != "RESTART_REQUIRED" {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Restarting openstack_db_instance_v1 %s to apply its configuration", instanceID)
+
+	err = instances.Restart(client, instanceID).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error restarting openstack_db_instance_v1 %s: %s", instanceID, err)
+	}
+
+	// The instance keeps reporting RESTART_REQUIRED until the restart starts.
+	pending := append([]string{"RESTART_REQUIRED"}, databaseInstanceV1PendingStates...)
+
+	return databaseInstanceV1WaitForState(ctx, client, instanceID, pending, []string{"ACTIVE", "HEALTHY"}, timeout)
+}
+
+// databaseInstanceV1DetachReplica detaches a replica from its replication
+// source. The replica becomes a standalone instance.
+func databaseInstanceV1DetachReplica(client *gophercloud.ServiceClient, instanceID string) error {
+	b := map[string]interface{}{
+		"instance": map[string]interface{}{
+			"replica_of": nil,
+			"slave_of":   nil,
+		},
+	}
+
+	_, err := client.Patch(client.ServiceURL("instances", instanceID), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+
+	return err
+}
+
+// databaseInstanceV1PromoteReplica promotes a replica to the replication
+// source. The former source becomes a replica of the promoted instance.
+func databaseInstanceV1PromoteReplica(client *gophercloud.ServiceClient, instanceID string) error {
+	b := map[string]interface{}{
+		"promote_to_replica_source": map[string]interface{}{},
+	}
+
+	_, err := client.Post(client.ServiceURL("instances", instanceID, "action"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+
+	return err
+}
@@ -1,6 +1,9 @@
 package vopencloud
 
 import (
+	"context"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -9,6 +12,8 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/db/v1/databases"
 	"github.com/gophercloud/gophercloud/openstack/db/v1/instances"
 	"github.com/gophercloud/gophercloud/openstack/db/v1/users"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
 )
 
 func TestUnitExpandDatabaseInstanceV1Datastore(t *testing.T) {
@@ -105,3 +110,126 @@ func TestUnitExpandDatabaseInstanceV1Users(t *testing.T) {
 	actual := expandDatabaseInstanceV1Users(userList)
 	assert.Equal(t, expected, actual)
 }
+
+func TestUnitDatabaseInstanceV1RestartIfRequired(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/d4603f69-ec7e-4e9b-803f-600b9205576f", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"instance": {"id": "d4603f69-ec7e-4e9b-803f-600b9205576f", "status": "ACTIVE"}}`)
+	})
+
+	th.Mux.HandleFunc("/instances/d4603f69-ec7e-4e9b-803f-600b9205576f/action", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("An ACTIVE instance must not be restarted")
+	})
+
+	err := databaseInstanceV1RestartIfRequired(context.TODO(), thclient.ServiceClient(), "d4603f69-ec7e-4e9b-803f-600b9205576f", 0)
+	assert.NoError(t, err)
+}
+
+func TestUnitFlattenDatabaseInstanceV1Networks(t *testing.T) {
+	details := &databaseInstanceV1Details{
+		Addresses: []databaseInstanceV1Address{
+			{Network: "net-2"},
+			{Network: "net-1"},
+			{Network: "net-1"},
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"uuid":        "net-2",
+			"port":        "",
+			"fixed_ip_v4": "",
+			"fixed_ip_v6": "",
+		},
+		{
+			"uuid":        "net-1",
+			"port":        "",
+			"fixed_ip_v4": "",
+			"fixed_ip_v6": "",
+		},
+	}
+
+	actual := flattenDatabaseInstanceV1Networks(details)
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnitDatabaseInstanceV1CurrentNetworks(t *testing.T) {
+	details := &databaseInstanceV1Details{
+		Addresses: []databaseInstanceV1Address{
+			{Network: "net-2"},
+			{Network: "net-1"},
+		},
+	}
+
+	// No network configured.
+	assert.Empty(t, databaseInstanceV1CurrentNetworks(details, []interface{}{}))
+
+	// Port-only and attached networks are kept as they are.
+	currentNetworks := []interface{}{
+		map[string]interface{}{
+			"uuid":        "",
+			"port":        "port-1",
+			"fixed_ip_v4": "",
+			"fixed_ip_v6": "",
+		},
+		map[string]interface{}{
+			"uuid":        "net-1",
+			"port":        "",
+			"fixed_ip_v4": "10.0.0.10",
+			"fixed_ip_v6": "",
+		},
+	}
+	assert.Equal(t, currentNetworks, databaseInstanceV1CurrentNetworks(details, currentNetworks))
+
+	// Detached networks are removed.
+	detachedNetwork := map[string]interface{}{
+		"uuid":        "net-3",
+		"port":        "",
+		"fixed_ip_v4": "",
+		"fixed_ip_v6": "",
+	}
+	actual := databaseInstanceV1CurrentNetworks(details, append(currentNetworks, detachedNetwork))
+	assert.Equal(t, currentNetworks, actual)
+
+	// Older Trove releases don't return the network of the addresses.
+	details = &databaseInstanceV1Details{
+		Addresses: []databaseInstanceV1Address{{}},
+	}
+	assert.Equal(t, []interface{}{detachedNetwork}, databaseInstanceV1CurrentNetworks(details, []interface{}{detachedNetwork}))
+}
+
+func TestUnitDatabaseInstanceV1DetachReplica(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/d4603f69-ec7e-4e9b-803f-600b9205576f", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestJSONRequest(t, r, `{"instance": {"replica_of": null, "slave_of": null}}`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	err := databaseInstanceV1DetachReplica(thclient.ServiceClient(), "d4603f69-ec7e-4e9b-803f-600b9205576f")
+	assert.NoError(t, err)
+}
+
+func TestUnitDatabaseInstanceV1PromoteReplica(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/d4603f69-ec7e-4e9b-803f-600b9205576f/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `{"promote_to_replica_source": {}}`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	err := databaseInstanceV1PromoteReplica(thclient.ServiceClient(), "d4603f69-ec7e-4e9b-803f-600b9205576f")
+	assert.NoError(t, err)
+}
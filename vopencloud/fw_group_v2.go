@@ -3,6 +3,8 @@ package vopencloud
 import (
 	"context"
 	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -84,3 +86,44 @@ func fwGroupV2EgressPolicyDeleteFunc(networkingClient *gophercloud.ServiceClient
 
 	return nil
 }
+
+// fwGroupV2UpdatePorts sets the ports of a firewall group and waits for the
+// group to settle.
+func fwGroupV2UpdatePorts(ctx context.Context, networkingClient *gophercloud.ServiceClient, groupID string, ports []string, timeout time.Duration) error {
+	updateOpts := groups.UpdateOpts{
+		Ports: &ports,
+	}
+
+	log.Printf("[DEBUG] openstack_fw_group_v2 %s update options: %#v", groupID, updateOpts)
+
+	_, err := groups.Update(networkingClient, groupID, updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating openstack_fw_group_v2 %s ports: %s", groupID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING_CREATE", "PENDING_UPDATE"},
+		Target:     []string{"ACTIVE", "INACTIVE", "DOWN"},
+		Refresh:    fwGroupV2RefreshFunc(networkingClient, groupID),
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_fw_group_v2 %s to become active: %s", groupID, err)
+	}
+
+	return nil
+}
+
+func parseFWGroupPortAssociationV2ID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unable to determine openstack_fw_group_port_association_v2 ID %s, "+
+			"format must be <group_id>/<port_id>", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
@@ -0,0 +1,19 @@
+package vopencloud
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitParseFWGroupPortAssociationV2ID(t *testing.T) {
+	groupID, portID, err := parseFWGroupPortAssociationV2ID("group-1/port-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "group-1", groupID)
+	assert.Equal(t, "port-1", portID)
+
+	for _, id := range []string{"group-1", "group-1/", "/port-1", "a/b/c"} {
+		_, _, err = parseFWGroupPortAssociationV2ID(id)
+		assert.Error(t, err)
+	}
+}
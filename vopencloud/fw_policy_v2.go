@@ -1,7 +1,9 @@
 package vopencloud
 
 import (
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
@@ -32,3 +34,40 @@ func fwPolicyV2DeleteFunc(networkingClient *gophercloud.ServiceClient, id string
 		return nil, "ACTIVE", err
 	}
 }
+
+// fwPolicyV2InsertRuleOpts represents the options to insert a rule into a
+// firewall policy. Unlike policies.InsertRuleOpts, both insert_before and
+// insert_after may be omitted to insert the rule at the top of the policy.
+type fwPolicyV2InsertRuleOpts struct {
+	ID           string `json:"firewall_rule_id" required:"true"`
+	InsertBefore string `json:"insert_before,omitempty"`
+	InsertAfter  string `json:"insert_after,omitempty"`
+}
+
+// ToFirewallPolicyInsertRuleMap casts a fwPolicyV2InsertRuleOpts struct to a
+// map.
+func (opts fwPolicyV2InsertRuleOpts) ToFirewallPolicyInsertRuleMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// fwPolicyV2RulePosition returns the position of the rule in the ordered
+// policy rules starting at 1, or 0 when the rule isn't part of the policy.
+func fwPolicyV2RulePosition(rules []string, ruleID string) int {
+	for i, rule := range rules {
+		if rule == ruleID {
+			return i + 1
+		}
+	}
+
+	return 0
+}
+
+func parseFWPolicyRuleAssociationV2ID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unable to determine openstack_fw_policy_rule_association_v2 ID %s, "+
+			"format must be <policy_id>/<rule_id>", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
@@ -0,0 +1,47 @@
+package vopencloud
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitFWPolicyV2InsertRuleOpts(t *testing.T) {
+	opts := fwPolicyV2InsertRuleOpts{
+		ID: "rule-1",
+	}
+
+	expected := map[string]interface{}{
+		"firewall_rule_id": "rule-1",
+	}
+	actual, err := opts.ToFirewallPolicyInsertRuleMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	opts.InsertAfter = "rule-2"
+	expected["insert_after"] = "rule-2"
+	actual, err = opts.ToFirewallPolicyInsertRuleMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnitFWPolicyV2RulePosition(t *testing.T) {
+	rules := []string{"rule-1", "rule-2", "rule-3"}
+
+	assert.Equal(t, 1, fwPolicyV2RulePosition(rules, "rule-1"))
+	assert.Equal(t, 3, fwPolicyV2RulePosition(rules, "rule-3"))
+	assert.Equal(t, 0, fwPolicyV2RulePosition(rules, "rule-4"))
+	assert.Equal(t, 0, fwPolicyV2RulePosition(nil, "rule-1"))
+}
+
+func TestUnitParseFWPolicyRuleAssociationV2ID(t *testing.T) {
+	policyID, ruleID, err := parseFWPolicyRuleAssociationV2ID("policy-1/rule-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "policy-1", policyID)
+	assert.Equal(t, "rule-1", ruleID)
+
+	for _, id := range []string{"policy-1", "policy-1/", "/rule-1", "a/b/c"} {
+		_, _, err = parseFWPolicyRuleAssociationV2ID(id)
+		assert.Error(t, err)
+	}
+}
@@ -214,15 +214,6 @@ func resourceImagesImageV2RefreshFunc(client *gophercloud.ServiceClient, id stri
 	}
 }
 
-func resourceImagesImageV2BuildTags(v []interface{}) []string {
-	tags := make([]string, len(v))
-	for i, tag := range v {
-		tags[i] = tag.(string)
-	}
-
-	return tags
-}
-
 func resourceImagesImageV2ExpandProperties(v map[string]interface{}) map[string]string {
 	properties := map[string]string{}
 	for key, value := range v {
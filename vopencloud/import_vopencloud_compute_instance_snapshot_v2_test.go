@@ -0,0 +1,35 @@
+package vopencloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccComputeV2InstanceSnapshot_importBasic(t *testing.T) {
+	resourceName := "openstack_compute_instance_snapshot_v2.snapshot_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckComputeV2InstanceSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2InstanceSnapshotBasic(),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"metadata",
+					"retain_on_delete",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,37 @@
+package vopencloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatabaseV1Cluster_importBasic(t *testing.T) {
+	resourceName := "openstack_db_cluster_v1.basic"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabaseCluster(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDatabaseV1ClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1ClusterBasic(3),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"network",
+					"availability_zone",
+					"locality",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,37 @@
+package vopencloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatabaseV1Instance_importBasic(t *testing.T) {
+	resourceName := "openstack_db_instance_v1.basic"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDatabaseV1InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1InstanceBasic(),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"database",
+					"user",
+					"replica_detach_mode",
+				},
+			},
+		},
+	})
+}
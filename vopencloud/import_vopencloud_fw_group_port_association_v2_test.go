@@ -0,0 +1,32 @@
+package vopencloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccFWGroupPortAssociationV2_importBasic(t *testing.T) {
+	resourceName := "openstack_fw_group_port_association_v2.association_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckFW(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckFWGroupV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFWGroupPortAssociationV2Basic,
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,33 @@
+package vopencloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesV1Import_basic(t *testing.T) {
+	resourceName := "openstack_kubernetes_v1.cluster_1"
+	clusterName := acctest.RandomWithPrefix("tf-acc-kubernetes")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckKubernetes(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckKubernetesV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesV1Basic(clusterName, 1),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
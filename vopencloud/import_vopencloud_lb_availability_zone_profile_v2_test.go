@@ -0,0 +1,32 @@
+package vopencloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLBAvailabilityZoneProfileV2_importBasic(t *testing.T) {
+	resourceName := "openstack_lb_availability_zone_profile_v2.azp_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBAvailabilityZoneProfileV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBAvailabilityZoneProfileV2Basic,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,243 @@
+package vopencloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+const kubernetesV1ServiceType = "kubernetes"
+
+// kubernetesClusterV1 represents a cluster of the Viettel managed Kubernetes
+// service.
+type kubernetesClusterV1 struct {
+	ID           string                 `json:"id"`
+	Name         string                 `json:"name"`
+	ProjectID    string                 `json:"project_id"`
+	Version      string                 `json:"version"`
+	Status       string                 `json:"status"`
+	StatusReason string                 `json:"status_reason"`
+	Endpoint     string                 `json:"endpoint"`
+	NetworkID    string                 `json:"network_id"`
+	SubnetID     string                 `json:"subnet_id"`
+	NodePools    []kubernetesNodePoolV1 `json:"node_pools"`
+	Metadata     map[string]string      `json:"metadata"`
+	CreatedAt    string                 `json:"created_at"`
+	UpdatedAt    string                 `json:"updated_at"`
+}
+
+// kubernetesNodePoolV1 represents a node pool of a managed Kubernetes
+// cluster.
+type kubernetesNodePoolV1 struct {
+	ID               string            `json:"id,omitempty"`
+	Name             string            `json:"name"`
+	FlavorID         string            `json:"flavor_id"`
+	NodeCount        int               `json:"node_count"`
+	AvailabilityZone string            `json:"availability_zone,omitempty"`
+	Labels           map[string]string `json:"labels,omitempty"`
+	Status           string            `json:"status,omitempty"`
+}
+
+// kubernetesClusterV1CreateOpts represents the attributes used when creating
+// a new managed Kubernetes cluster.
+type kubernetesClusterV1CreateOpts struct {
+	Name      string                 `json:"name" required:"true"`
+	Version   string                 `json:"version" required:"true"`
+	NetworkID string                 `json:"network_id" required:"true"`
+	SubnetID  string                 `json:"subnet_id" required:"true"`
+	NodePools []kubernetesNodePoolV1 `json:"node_pools" required:"true"`
+	Metadata  map[string]string      `json:"metadata,omitempty"`
+}
+
+// kubernetesClusterV1UpdateOpts represents the attributes used when updating
+// an existing managed Kubernetes cluster.
+type kubernetesClusterV1UpdateOpts struct {
+	Version   string                 `json:"version,omitempty"`
+	NodePools []kubernetesNodePoolV1 `json:"node_pools,omitempty"`
+	Metadata  *map[string]string     `json:"metadata,omitempty"`
+}
+
+// kubernetesClusterV1ListOpts allows to filter the list of managed Kubernetes
+// clusters.
+type kubernetesClusterV1ListOpts struct {
+	Name string `q:"name"`
+}
+
+// kubernetesClusterV1Credentials holds the PEM encoded certificates used to
+// build a kubeconfig for a managed Kubernetes cluster.
+type kubernetesClusterV1Credentials struct {
+	CertificateAuthority string `json:"certificate_authority"`
+	ClientCertificate    string `json:"client_certificate"`
+	ClientKey            string `json:"client_key"`
+}
+
+// newKubernetesV1 creates a ServiceClient for the managed Kubernetes service.
+// Requests made with it carry the Keystone token of the provider client.
+func newKubernetesV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
+	sc := new(gophercloud.ServiceClient)
+	eo.ApplyDefaults(kubernetesV1ServiceType)
+	url, err := client.EndpointLocator(eo)
+	if err != nil {
+		return sc, err
+	}
+	sc.ProviderClient = client
+	sc.Endpoint = url
+	sc.Type = kubernetesV1ServiceType
+	return sc, nil
+}
+
+func kubernetesClusterV1Create(client *gophercloud.ServiceClient, opts kubernetesClusterV1CreateOpts) (*kubernetesClusterV1, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "cluster")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Cluster kubernetesClusterV1 `json:"cluster"`
+	}
+	_, err = client.Post(client.ServiceURL("clusters"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{201, 202},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Cluster, nil
+}
+
+func kubernetesClusterV1Get(client *gophercloud.ServiceClient, id string) (*kubernetesClusterV1, error) {
+	var r struct {
+		Cluster kubernetesClusterV1 `json:"cluster"`
+	}
+	_, err := client.Get(client.ServiceURL("clusters", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Cluster, nil
+}
+
+func kubernetesClusterV1List(client *gophercloud.ServiceClient, opts kubernetesClusterV1ListOpts) ([]kubernetesClusterV1, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Clusters []kubernetesClusterV1 `json:"clusters"`
+	}
+	_, err = client.Get(client.ServiceURL("clusters")+q.String(), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Clusters, nil
+}
+
+func kubernetesClusterV1GetCredentials(client *gophercloud.ServiceClient, id string) (*kubernetesClusterV1Credentials, error) {
+	var r struct {
+		Credentials kubernetesClusterV1Credentials `json:"credentials"`
+	}
+	_, err := client.Get(client.ServiceURL("clusters", id, "credentials"), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Credentials, nil
+}
+
+func kubernetesClusterV1Update(client *gophercloud.ServiceClient, id string, opts kubernetesClusterV1UpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "cluster")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Patch(client.ServiceURL("clusters", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+
+	return err
+}
+
+func kubernetesClusterV1Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("clusters", id), nil)
+
+	return err
+}
+
+// kubernetesClusterV1StateRefreshFunc returns a resource.StateRefreshFunc
+// that is used to watch a managed Kubernetes cluster.
+func kubernetesClusterV1StateRefreshFunc(client *gophercloud.ServiceClient, clusterID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		c, err := kubernetesClusterV1Get(client, clusterID)
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return c, "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		if c.Status == "ERROR" {
+			return c, c.Status, fmt.Errorf("openstack_kubernetes_v1 is in an error state: %s", c.StatusReason)
+		}
+
+		return c, c.Status, nil
+	}
+}
+
+func expandKubernetesV1NodePools(v []interface{}) []kubernetesNodePoolV1 {
+	nodePools := make([]kubernetesNodePoolV1, 0, len(v))
+	for _, raw := range v {
+		rawMap := raw.(map[string]interface{})
+		id, _ := rawMap["id"].(string)
+		nodePools = append(nodePools, kubernetesNodePoolV1{
+			ID:               id,
+			Name:             rawMap["name"].(string),
+			FlavorID:         rawMap["flavor_id"].(string),
+			NodeCount:        rawMap["node_count"].(int),
+			AvailabilityZone: rawMap["availability_zone"].(string),
+			Labels:           expandToMapStringString(rawMap["labels"].(map[string]interface{})),
+		})
+	}
+
+	return nodePools
+}
+
+func flattenKubernetesV1NodePools(nodePools []kubernetesNodePoolV1) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(nodePools))
+	for _, np := range nodePools {
+		res = append(res, map[string]interface{}{
+			"id":                np.ID,
+			"name":              np.Name,
+			"flavor_id":         np.FlavorID,
+			"node_count":        np.NodeCount,
+			"availability_zone": np.AvailabilityZone,
+			"labels":            np.Labels,
+			"status":            np.Status,
+		})
+	}
+
+	return res
+}
+
+func flattenKubernetesV1Kubeconfig(client *gophercloud.ServiceClient, c *kubernetesClusterV1) (map[string]interface{}, error) {
+	credentials, err := kubernetesClusterV1GetCredentials(client, c.ID)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting cluster credentials: %s", err)
+	}
+
+	rawKubeconfig, err := renderKubeconfig(c.Name, c.Endpoint, []byte(credentials.CertificateAuthority), []byte(credentials.ClientCertificate), []byte(credentials.ClientKey))
+	if err != nil {
+		return nil, fmt.Errorf("Error rendering kubeconfig: %s", err)
+	}
+
+	return map[string]interface{}{
+		"raw_config":             string(rawKubeconfig),
+		"host":                   c.Endpoint,
+		"cluster_ca_certificate": credentials.CertificateAuthority,
+		"client_certificate":     credentials.ClientCertificate,
+		"client_key":             credentials.ClientKey,
+	}, nil
+}
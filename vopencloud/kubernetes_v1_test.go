@@ -0,0 +1,283 @@
+package vopencloud
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const testKubernetesClusterV1Response = `
+{
+  "cluster": {
+    "id": "a1b2c3",
+    "name": "cluster_1",
+    "project_id": "p1",
+    "version": "1.27",
+    "status": "%s",
+    "status_reason": "%s",
+    "endpoint": "https://10.0.0.10:6443",
+    "network_id": "n1",
+    "subnet_id": "s1",
+    "node_pools": [
+      {
+        "id": "np1",
+        "name": "default",
+        "flavor_id": "f1",
+        "node_count": 3,
+        "labels": {
+          "role": "worker"
+        },
+        "status": "ACTIVE"
+      }
+    ],
+    "metadata": {
+      "foo": "bar"
+    }
+  }
+}
+`
+
+func TestUnitKubernetesClusterV1Create(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/clusters", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", thclient.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+  "cluster": {
+    "name": "cluster_1",
+    "version": "1.27",
+    "network_id": "n1",
+    "subnet_id": "s1",
+    "node_pools": [
+      {
+        "name": "default",
+        "flavor_id": "f1",
+        "node_count": 3,
+        "labels": {
+          "role": "worker"
+        }
+      }
+    ],
+    "metadata": {
+      "foo": "bar"
+    }
+  }
+}
+`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, testKubernetesClusterV1Response, "CREATING", "")
+	})
+
+	createOpts := kubernetesClusterV1CreateOpts{
+		Name:      "cluster_1",
+		Version:   "1.27",
+		NetworkID: "n1",
+		SubnetID:  "s1",
+		NodePools: expandKubernetesV1NodePools([]interface{}{
+			map[string]interface{}{
+				"name":              "default",
+				"flavor_id":         "f1",
+				"node_count":        3,
+				"availability_zone": "",
+				"labels": map[string]interface{}{
+					"role": "worker",
+				},
+			},
+		}),
+		Metadata: map[string]string{
+			"foo": "bar",
+		},
+	}
+
+	actual, err := kubernetesClusterV1Create(thclient.ServiceClient(), createOpts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "a1b2c3", actual.ID)
+	assert.Equal(t, "CREATING", actual.Status)
+	assert.Equal(t, 3, actual.NodePools[0].NodeCount)
+}
+
+func TestUnitKubernetesClusterV1StateRefreshFunc(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	status := "ACTIVE"
+	th.Mux.HandleFunc("/clusters/a1b2c3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		if status == "" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, testKubernetesClusterV1Response, status, "quota exceeded")
+	})
+
+	refresh := kubernetesClusterV1StateRefreshFunc(thclient.ServiceClient(), "a1b2c3")
+
+	_, actual, err := refresh()
+	assert.NoError(t, err)
+	assert.Equal(t, "ACTIVE", actual)
+
+	status = "ERROR"
+	_, actual, err = refresh()
+	assert.EqualError(t, err, "openstack_kubernetes_v1 is in an error state: quota exceeded")
+	assert.Equal(t, "ERROR", actual)
+
+	status = ""
+	_, actual, err = refresh()
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETED", actual)
+}
+
+func TestUnitKubernetesClusterV1Update(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/clusters/a1b2c3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestJSONRequest(t, r, `{"cluster": {"version": "1.28"}}`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	err := kubernetesClusterV1Update(thclient.ServiceClient(), "a1b2c3", kubernetesClusterV1UpdateOpts{
+		Version: "1.28",
+	})
+
+	assert.NoError(t, err)
+}
+
+func TestUnitFlattenKubernetesV1NodePools(t *testing.T) {
+	nodePools := []kubernetesNodePoolV1{
+		{
+			ID:        "np1",
+			Name:      "default",
+			FlavorID:  "f1",
+			NodeCount: 3,
+			Status:    "ACTIVE",
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"id":                "np1",
+			"name":              "default",
+			"flavor_id":         "f1",
+			"node_count":        3,
+			"availability_zone": "",
+			"labels":            map[string]string(nil),
+			"status":            "ACTIVE",
+		},
+	}
+
+	assert.Equal(t, expected, flattenKubernetesV1NodePools(nodePools))
+}
+
+func TestUnitExpandKubernetesV1NodePools(t *testing.T) {
+	raw := []interface{}{
+		map[string]interface{}{
+			"id":                "np1",
+			"name":              "default",
+			"flavor_id":         "f1",
+			"node_count":        4,
+			"availability_zone": "nova",
+			"labels":            map[string]interface{}{},
+		},
+		map[string]interface{}{
+			"id":                "",
+			"name":              "extra",
+			"flavor_id":         "f2",
+			"node_count":        1,
+			"availability_zone": "",
+			"labels":            map[string]interface{}{},
+		},
+	}
+
+	expected := []kubernetesNodePoolV1{
+		{
+			ID:               "np1",
+			Name:             "default",
+			FlavorID:         "f1",
+			NodeCount:        4,
+			AvailabilityZone: "nova",
+			Labels:           map[string]string{},
+		},
+		{
+			Name:      "extra",
+			FlavorID:  "f2",
+			NodeCount: 1,
+			Labels:    map[string]string{},
+		},
+	}
+
+	assert.Equal(t, expected, expandKubernetesV1NodePools(raw))
+}
+
+func TestUnitKubernetesClusterV1List(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/clusters", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"name": "cluster_1"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"clusters": [{"id": "a1b2c3", "name": "cluster_1"}, {"id": "d4e5f6", "name": "cluster_1"}]}`)
+	})
+
+	actual, err := kubernetesClusterV1List(thclient.ServiceClient(), kubernetesClusterV1ListOpts{
+		Name: "cluster_1",
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, actual, 2)
+	assert.Equal(t, "d4e5f6", actual[1].ID)
+}
+
+func TestUnitFlattenKubernetesV1Kubeconfig(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/clusters/a1b2c3/credentials", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"credentials": {"certificate_authority": "ca", "client_certificate": "cert", "client_key": "key"}}`)
+	})
+
+	cluster := &kubernetesClusterV1{
+		ID:       "a1b2c3",
+		Name:     "cluster_1",
+		Endpoint: "https://10.0.0.10:6443",
+	}
+
+	rawKubeconfig, err := renderKubeconfig("cluster_1", "https://10.0.0.10:6443", []byte("ca"), []byte("cert"), []byte("key"))
+	assert.NoError(t, err)
+
+	expected := map[string]interface{}{
+		"raw_config":             string(rawKubeconfig),
+		"host":                   "https://10.0.0.10:6443",
+		"cluster_ca_certificate": "ca",
+		"client_certificate":     "cert",
+		"client_key":             "key",
+	}
+
+	actual, err := flattenKubernetesV1Kubeconfig(thclient.ServiceClient(), cluster)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
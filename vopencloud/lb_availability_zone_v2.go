@@ -0,0 +1,176 @@
+package vopencloud
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// lbAvailabilityZoneProfileV2 represents an Octavia availability zone
+// profile.
+type lbAvailabilityZoneProfileV2 struct {
+	ID                   string `json:"id"`
+	Name                 string `json:"name"`
+	ProviderName         string `json:"provider_name"`
+	AvailabilityZoneData string `json:"availability_zone_data"`
+}
+
+// lbAvailabilityZoneProfileV2CreateOpts represents the attributes used when
+// creating a new Octavia availability zone profile.
+type lbAvailabilityZoneProfileV2CreateOpts struct {
+	Name                 string `json:"name" required:"true"`
+	ProviderName         string `json:"provider_name" required:"true"`
+	AvailabilityZoneData string `json:"availability_zone_data" required:"true"`
+}
+
+// lbAvailabilityZoneProfileV2UpdateOpts represents the attributes used when
+// updating an existing Octavia availability zone profile.
+type lbAvailabilityZoneProfileV2UpdateOpts struct {
+	Name                 *string `json:"name,omitempty"`
+	ProviderName         *string `json:"provider_name,omitempty"`
+	AvailabilityZoneData *string `json:"availability_zone_data,omitempty"`
+}
+
+// lbAvailabilityZoneProfileV2ListOpts allows to filter the list of Octavia
+// availability zone profiles.
+type lbAvailabilityZoneProfileV2ListOpts struct {
+	ID           string `q:"id"`
+	Name         string `q:"name"`
+	ProviderName string `q:"provider_name"`
+}
+
+// lbAvailabilityZoneV2 represents an Octavia availability zone. The name is
+// the unique identifier of an availability zone.
+type lbAvailabilityZoneV2 struct {
+	Name                      string `json:"name"`
+	Description               string `json:"description"`
+	AvailabilityZoneProfileID string `json:"availability_zone_profile_id"`
+	Enabled                   bool   `json:"enabled"`
+}
+
+// lbAvailabilityZoneV2CreateOpts represents the attributes used when creating
+// a new Octavia availability zone.
+type lbAvailabilityZoneV2CreateOpts struct {
+	Name                      string `json:"name" required:"true"`
+	Description               string `json:"description,omitempty"`
+	AvailabilityZoneProfileID string `json:"availability_zone_profile_id" required:"true"`
+	Enabled                   *bool  `json:"enabled,omitempty"`
+}
+
+// lbAvailabilityZoneV2UpdateOpts represents the attributes used when updating
+// an existing Octavia availability zone.
+type lbAvailabilityZoneV2UpdateOpts struct {
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+func lbAvailabilityZoneProfileV2Create(client *gophercloud.ServiceClient, opts lbAvailabilityZoneProfileV2CreateOpts) (*lbAvailabilityZoneProfileV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "availability_zone_profile")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		AvailabilityZoneProfile lbAvailabilityZoneProfileV2 `json:"availability_zone_profile"`
+	}
+	_, err = client.Post(client.ServiceURL("lbaas", "availabilityzoneprofiles"), b, &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.AvailabilityZoneProfile, nil
+}
+
+func lbAvailabilityZoneProfileV2Get(client *gophercloud.ServiceClient, id string) (*lbAvailabilityZoneProfileV2, error) {
+	var r struct {
+		AvailabilityZoneProfile lbAvailabilityZoneProfileV2 `json:"availability_zone_profile"`
+	}
+	_, err := client.Get(client.ServiceURL("lbaas", "availabilityzoneprofiles", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.AvailabilityZoneProfile, nil
+}
+
+func lbAvailabilityZoneProfileV2Update(client *gophercloud.ServiceClient, id string, opts lbAvailabilityZoneProfileV2UpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "availability_zone_profile")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(client.ServiceURL("lbaas", "availabilityzoneprofiles", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+func lbAvailabilityZoneProfileV2Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("lbaas", "availabilityzoneprofiles", id), nil)
+
+	return err
+}
+
+func lbAvailabilityZoneProfileV2List(client *gophercloud.ServiceClient, opts lbAvailabilityZoneProfileV2ListOpts) ([]lbAvailabilityZoneProfileV2, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		AvailabilityZoneProfiles []lbAvailabilityZoneProfileV2 `json:"availability_zone_profiles"`
+	}
+	_, err = client.Get(client.ServiceURL("lbaas", "availabilityzoneprofiles")+q.String(), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.AvailabilityZoneProfiles, nil
+}
+
+func lbAvailabilityZoneV2Create(client *gophercloud.ServiceClient, opts lbAvailabilityZoneV2CreateOpts) (*lbAvailabilityZoneV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "availability_zone")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		AvailabilityZone lbAvailabilityZoneV2 `json:"availability_zone"`
+	}
+	_, err = client.Post(client.ServiceURL("lbaas", "availabilityzones"), b, &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.AvailabilityZone, nil
+}
+
+func lbAvailabilityZoneV2Get(client *gophercloud.ServiceClient, name string) (*lbAvailabilityZoneV2, error) {
+	var r struct {
+		AvailabilityZone lbAvailabilityZoneV2 `json:"availability_zone"`
+	}
+	_, err := client.Get(client.ServiceURL("lbaas", "availabilityzones", name), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.AvailabilityZone, nil
+}
+
+func lbAvailabilityZoneV2Update(client *gophercloud.ServiceClient, name string, opts lbAvailabilityZoneV2UpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "availability_zone")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(client.ServiceURL("lbaas", "availabilityzones", name), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+func lbAvailabilityZoneV2Delete(client *gophercloud.ServiceClient, name string) error {
+	_, err := client.Delete(client.ServiceURL("lbaas", "availabilityzones", name), nil)
+
+	return err
+}
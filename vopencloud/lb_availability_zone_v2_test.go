@@ -0,0 +1,73 @@
+package vopencloud
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestUnitLBAvailabilityZoneV2Update(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/lbaas/availabilityzones/az-1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, `
+{
+  "availability_zone": {
+    "enabled": false
+  }
+}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `
+{
+  "availability_zone": {
+    "name": "az-1",
+    "availability_zone_profile_id": "4bc0f4ec-3b37-4e94-8b8d-6d8aa3ac2d5e",
+    "enabled": false
+  }
+}`)
+	})
+
+	enabled := false
+	err := lbAvailabilityZoneV2Update(thclient.ServiceClient(), "az-1", lbAvailabilityZoneV2UpdateOpts{
+		Enabled: &enabled,
+	})
+	assert.NoError(t, err)
+}
+
+func TestUnitLBAvailabilityZoneProfileV2Get(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/lbaas/availabilityzoneprofiles/4bc0f4ec-3b37-4e94-8b8d-6d8aa3ac2d5e", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `
+{
+  "availability_zone_profile": {
+    "id": "4bc0f4ec-3b37-4e94-8b8d-6d8aa3ac2d5e",
+    "name": "nova-zone",
+    "provider_name": "amphora",
+    "availability_zone_data": "{\"compute_zone\": \"nova\"}"
+  }
+}`)
+	})
+
+	azp, err := lbAvailabilityZoneProfileV2Get(thclient.ServiceClient(), "4bc0f4ec-3b37-4e94-8b8d-6d8aa3ac2d5e")
+	assert.NoError(t, err)
+	assert.Equal(t, "nova-zone", azp.Name)
+	assert.Equal(t, `{"compute_zone":"nova"}`, normalizeLBV2JSONData(azp.AvailabilityZoneData))
+
+	_, err = lbAvailabilityZoneProfileV2Get(thclient.ServiceClient(), "unknown")
+	assert.Error(t, err)
+}
@@ -0,0 +1,216 @@
+package vopencloud
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// lbFlavorProfileV2 represents an Octavia flavor profile.
+type lbFlavorProfileV2 struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	ProviderName string `json:"provider_name"`
+	FlavorData   string `json:"flavor_data"`
+}
+
+// lbFlavorProfileV2CreateOpts represents the attributes used when creating
+// a new Octavia flavor profile.
+type lbFlavorProfileV2CreateOpts struct {
+	Name         string `json:"name" required:"true"`
+	ProviderName string `json:"provider_name" required:"true"`
+	FlavorData   string `json:"flavor_data" required:"true"`
+}
+
+// lbFlavorProfileV2UpdateOpts represents the attributes used when updating
+// an existing Octavia flavor profile.
+type lbFlavorProfileV2UpdateOpts struct {
+	Name         *string `json:"name,omitempty"`
+	ProviderName *string `json:"provider_name,omitempty"`
+	FlavorData   *string `json:"flavor_data,omitempty"`
+}
+
+// lbFlavorProfileV2ListOpts allows to filter the list of Octavia flavor
+// profiles.
+type lbFlavorProfileV2ListOpts struct {
+	ID           string `q:"id"`
+	Name         string `q:"name"`
+	ProviderName string `q:"provider_name"`
+}
+
+// lbFlavorV2 represents an Octavia flavor.
+type lbFlavorV2 struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	FlavorProfileID string `json:"flavor_profile_id"`
+	Enabled         bool   `json:"enabled"`
+}
+
+// lbFlavorV2CreateOpts represents the attributes used when creating a new
+// Octavia flavor.
+type lbFlavorV2CreateOpts struct {
+	Name            string `json:"name" required:"true"`
+	Description     string `json:"description,omitempty"`
+	FlavorProfileID string `json:"flavor_profile_id" required:"true"`
+	Enabled         *bool  `json:"enabled,omitempty"`
+}
+
+// lbFlavorV2UpdateOpts represents the attributes used when updating an
+// existing Octavia flavor.
+type lbFlavorV2UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+// lbFlavorV2ListOpts allows to filter the list of Octavia flavors.
+type lbFlavorV2ListOpts struct {
+	ID   string `q:"id"`
+	Name string `q:"name"`
+}
+
+func lbFlavorProfileV2Create(client *gophercloud.ServiceClient, opts lbFlavorProfileV2CreateOpts) (*lbFlavorProfileV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "flavorprofile")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		FlavorProfile lbFlavorProfileV2 `json:"flavorprofile"`
+	}
+	_, err = client.Post(client.ServiceURL("lbaas", "flavorprofiles"), b, &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.FlavorProfile, nil
+}
+
+func lbFlavorProfileV2Get(client *gophercloud.ServiceClient, id string) (*lbFlavorProfileV2, error) {
+	var r struct {
+		FlavorProfile lbFlavorProfileV2 `json:"flavorprofile"`
+	}
+	_, err := client.Get(client.ServiceURL("lbaas", "flavorprofiles", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.FlavorProfile, nil
+}
+
+func lbFlavorProfileV2Update(client *gophercloud.ServiceClient, id string, opts lbFlavorProfileV2UpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "flavorprofile")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(client.ServiceURL("lbaas", "flavorprofiles", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+func lbFlavorProfileV2Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("lbaas", "flavorprofiles", id), nil)
+
+	return err
+}
+
+func lbFlavorProfileV2List(client *gophercloud.ServiceClient, opts lbFlavorProfileV2ListOpts) ([]lbFlavorProfileV2, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		FlavorProfiles []lbFlavorProfileV2 `json:"flavorprofiles"`
+	}
+	_, err = client.Get(client.ServiceURL("lbaas", "flavorprofiles")+q.String(), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.FlavorProfiles, nil
+}
+
+func lbFlavorV2Create(client *gophercloud.ServiceClient, opts lbFlavorV2CreateOpts) (*lbFlavorV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "flavor")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Flavor lbFlavorV2 `json:"flavor"`
+	}
+	_, err = client.Post(client.ServiceURL("lbaas", "flavors"), b, &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Flavor, nil
+}
+
+func lbFlavorV2Get(client *gophercloud.ServiceClient, id string) (*lbFlavorV2, error) {
+	var r struct {
+		Flavor lbFlavorV2 `json:"flavor"`
+	}
+	_, err := client.Get(client.ServiceURL("lbaas", "flavors", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Flavor, nil
+}
+
+func lbFlavorV2Update(client *gophercloud.ServiceClient, id string, opts lbFlavorV2UpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "flavor")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(client.ServiceURL("lbaas", "flavors", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+func lbFlavorV2Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("lbaas", "flavors", id), nil)
+
+	return err
+}
+
+func lbFlavorV2List(client *gophercloud.ServiceClient, opts lbFlavorV2ListOpts) ([]lbFlavorV2, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Flavors []lbFlavorV2 `json:"flavors"`
+	}
+	_, err = client.Get(client.ServiceURL("lbaas", "flavors")+q.String(), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Flavors, nil
+}
+
+// normalizeLBV2JSONData normalizes the JSON data of Octavia profiles, so it
+// matches the state saved by the schema StateFunc.
+func normalizeLBV2JSONData(v string) string {
+	if v == "" {
+		return v
+	}
+
+	json, err := structure.NormalizeJsonString(v)
+	if err != nil {
+		return v
+	}
+
+	return json
+}
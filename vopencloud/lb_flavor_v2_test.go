@@ -0,0 +1,81 @@
+package vopencloud
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestUnitLBFlavorProfileV2Create(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/lbaas/flavorprofiles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+{
+  "flavorprofile": {
+    "name": "amphora-single",
+    "provider_name": "amphora",
+    "flavor_data": "{\"loadbalancer_topology\": \"SINGLE\"}"
+  }
+}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `
+{
+  "flavorprofile": {
+    "id": "5a9f1e0e-ad5b-4d39-a0f1-6f3b1b5c2a3d",
+    "name": "amphora-single",
+    "provider_name": "amphora",
+    "flavor_data": "{\"loadbalancer_topology\": \"SINGLE\"}"
+  }
+}`)
+	})
+
+	fp, err := lbFlavorProfileV2Create(thclient.ServiceClient(), lbFlavorProfileV2CreateOpts{
+		Name:         "amphora-single",
+		ProviderName: "amphora",
+		FlavorData:   `{"loadbalancer_topology": "SINGLE"}`,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "5a9f1e0e-ad5b-4d39-a0f1-6f3b1b5c2a3d", fp.ID)
+	assert.Equal(t, `{"loadbalancer_topology":"SINGLE"}`, normalizeLBV2JSONData(fp.FlavorData))
+}
+
+func TestUnitLBFlavorV2List(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/lbaas/flavors", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"name": "basic"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `
+{
+  "flavors": [
+    {
+      "id": "8f94060c-8b5b-4472-9da8-ac5e1f8e1f8e",
+      "name": "basic",
+      "description": "A basic flavor",
+      "flavor_profile_id": "5a9f1e0e-ad5b-4d39-a0f1-6f3b1b5c2a3d",
+      "enabled": true
+    }
+  ]
+}`)
+	})
+
+	flavors, err := lbFlavorV2List(thclient.ServiceClient(), lbFlavorV2ListOpts{Name: "basic"})
+	assert.NoError(t, err)
+	assert.Len(t, flavors, 1)
+	assert.Equal(t, "5a9f1e0e-ad5b-4d39-a0f1-6f3b1b5c2a3d", flavors[0].FlavorProfileID)
+	assert.True(t, flavors[0].Enabled)
+}
@@ -0,0 +1,209 @@
+package vopencloud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+)
+
+// lbMembersV2SourceArguments are the member_source arguments, which select
+// the instances used as pool members and set the member attributes.
+var lbMembersV2SourceArguments = []string{
+	"server_group_id", "metadata", "tags", "subnet_id",
+	"protocol_port", "weight", "monitor_port", "backup", "admin_state_up",
+}
+
+// resourceMembersV2CustomizeDiff resolves the member_source instances into
+// the planned pool members, so that the pool is kept in sync as instances are
+// created or deleted.
+func resourceMembersV2CustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	v, ok := diff.GetOk("member_source")
+	if !ok {
+		// "member" is computed to support member_source. Without a member
+		// source, removing all member blocks must still remove the members.
+		raw := diff.GetRawConfig().GetAttr("member")
+		if raw.IsKnown() && (raw.IsNull() || raw.LengthInt() == 0) {
+			if o, _ := diff.GetChange("member"); o.(*schema.Set).Len() > 0 {
+				return diff.SetNew("member", []interface{}{})
+			}
+		}
+		return nil
+	}
+
+	// Skip the resolution, when one of the inputs isn't known yet, because
+	// unknown values are read as zero values.
+	for _, k := range lbMembersV2SourceArguments {
+		if !diff.NewValueKnown("member_source.0." + k) {
+			return diff.SetNewComputed("member")
+		}
+	}
+
+	config := meta.(*Config)
+	region := config.Region
+	if v, ok := diff.GetOk("region"); ok {
+		region = v.(string)
+	}
+
+	members, err := resolveLBMembersV2Source(config, region, v.([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	return diff.SetNew("member", members)
+}
+
+// resolveLBMembersV2Source returns the pool members of the instances selected
+// by the member_source argument.
+func resolveLBMembersV2Source(config *Config, region string, source []interface{}) ([]interface{}, error) {
+	computeClient, err := config.ComputeV2Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	networkingClient, err := config.NetworkingV2Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	return lbMembersV2SourceMembers(computeClient, networkingClient, source[0].(map[string]interface{}))
+}
+
+func lbMembersV2SourceMembers(computeClient, networkingClient *gophercloud.ServiceClient, source map[string]interface{}) ([]interface{}, error) {
+	var listOpts servers.ListOpts
+
+	if v, ok := source["tags"].(*schema.Set); ok && v.Len() > 0 {
+		tags := expandToStringSlice(v.List())
+		sort.Strings(tags)
+		listOpts.Tags = strings.Join(tags, ",")
+		computeClient.Microversion = computeV2TagsExtensionMicroversion
+	}
+
+	var groupMembers map[string]bool
+	if v := source["server_group_id"].(string); v != "" {
+		sg, err := servergroups.Get(computeClient, v).Extract()
+		if err != nil {
+			return nil, fmt.Errorf("Unable to retrieve server group %s: %s", v, err)
+		}
+
+		groupMembers = make(map[string]bool, len(sg.Members))
+		for _, id := range sg.Members {
+			groupMembers[id] = true
+		}
+	}
+
+	allPages, err := servers.List(computeClient, listOpts).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("Unable to list instances: %s", err)
+	}
+
+	allServers, err := servers.ExtractServers(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to extract instances: %s", err)
+	}
+
+	metadata := source["metadata"].(map[string]interface{})
+
+	var selected []servers.Server
+	for _, s := range allServers {
+		if groupMembers != nil && !groupMembers[s.ID] {
+			continue
+		}
+		if !lbMembersV2MatchMetadata(s.Metadata, metadata) {
+			continue
+		}
+		selected = append(selected, s)
+	}
+
+	subnetID := source["subnet_id"].(string)
+	addresses, err := lbMembersV2SubnetAddresses(networkingClient, subnetID)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(selected, func(i, j int) bool {
+		if selected[i].Name != selected[j].Name {
+			return selected[i].Name < selected[j].Name
+		}
+		return selected[i].ID < selected[j].ID
+	})
+
+	members := make([]interface{}, 0, len(selected))
+	for _, s := range selected {
+		address, ok := addresses[s.ID]
+		if !ok {
+			continue
+		}
+
+		members = append(members, map[string]interface{}{
+			"name":            s.Name,
+			"address":         address,
+			"protocol_port":   source["protocol_port"].(int),
+			"weight":          source["weight"].(int),
+			"monitor_port":    source["monitor_port"].(int),
+			"monitor_address": "",
+			"subnet_id":       subnetID,
+			"backup":          source["backup"].(bool),
+			"admin_state_up":  source["admin_state_up"].(bool),
+		})
+	}
+
+	return members, nil
+}
+
+func lbMembersV2MatchMetadata(actual map[string]string, expected map[string]interface{}) bool {
+	for k, v := range expected {
+		if actual[k] != v.(string) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// lbMembersV2SubnetAddresses maps the device IDs of the ports in the subnet
+// to their IP address in the subnet.
+func lbMembersV2SubnetAddresses(networkingClient *gophercloud.ServiceClient, subnetID string) (map[string]string, error) {
+	listOpts := ports.ListOpts{
+		FixedIPs: []ports.FixedIPOpts{
+			{
+				SubnetID: subnetID,
+			},
+		},
+	}
+
+	allPages, err := ports.List(networkingClient, listOpts).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("Unable to list ports of subnet %s: %s", subnetID, err)
+	}
+
+	allPorts, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to extract ports of subnet %s: %s", subnetID, err)
+	}
+
+	addresses := make(map[string]string, len(allPorts))
+	for _, p := range allPorts {
+		if p.DeviceID == "" {
+			continue
+		}
+		if _, ok := addresses[p.DeviceID]; ok {
+			continue
+		}
+		for _, ip := range p.FixedIPs {
+			if ip.SubnetID == subnetID {
+				addresses[p.DeviceID] = ip.IPAddress
+				break
+			}
+		}
+	}
+
+	return addresses, nil
+}
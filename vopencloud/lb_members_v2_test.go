@@ -0,0 +1,154 @@
+package vopencloud
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestUnitLBMembersV2SourceMembers(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/os-server-groups/sg_1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `
+{
+  "server_group": {
+    "id": "sg_1",
+    "name": "web",
+    "policies": ["anti-affinity"],
+    "members": ["server_1", "server_2", "server_3"]
+  }
+}`)
+	})
+
+	th.Mux.HandleFunc("/servers/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"tags": "lb,web"})
+		th.TestHeader(t, r, "X-OpenStack-Nova-API-Version", "2.26")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `
+{
+  "servers": [
+    {
+      "id": "server_2",
+      "name": "web-2",
+      "metadata": {"role": "web"}
+    },
+    {
+      "id": "server_1",
+      "name": "web-1",
+      "metadata": {"role": "web", "zone": "a"}
+    },
+    {
+      "id": "server_3",
+      "name": "web-3",
+      "metadata": {"role": "db"}
+    },
+    {
+      "id": "server_4",
+      "name": "web-4",
+      "metadata": {"role": "web"}
+    }
+  ]
+}`)
+	})
+
+	th.Mux.HandleFunc("/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"fixed_ips": "subnet_id=subnet_1"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `
+{
+  "ports": [
+    {
+      "id": "port_1",
+      "device_id": "server_1",
+      "fixed_ips": [
+        {"subnet_id": "subnet_2", "ip_address": "fd00::11"},
+        {"subnet_id": "subnet_1", "ip_address": "192.168.199.11"}
+      ]
+    },
+    {
+      "id": "port_2",
+      "device_id": "server_2",
+      "fixed_ips": [
+        {"subnet_id": "subnet_1", "ip_address": "192.168.199.12"}
+      ]
+    },
+    {
+      "id": "port_3",
+      "device_id": "server_3",
+      "fixed_ips": [
+        {"subnet_id": "subnet_1", "ip_address": "192.168.199.13"}
+      ]
+    },
+    {
+      "id": "port_4",
+      "device_id": "",
+      "fixed_ips": [
+        {"subnet_id": "subnet_1", "ip_address": "192.168.199.1"}
+      ]
+    }
+  ]
+}`)
+	})
+
+	source := map[string]interface{}{
+		"server_group_id": "sg_1",
+		"metadata":        map[string]interface{}{"role": "web"},
+		"tags":            schema.NewSet(schema.HashString, []interface{}{"web", "lb"}),
+		"subnet_id":       "subnet_1",
+		"protocol_port":   8080,
+		"weight":          1,
+		"monitor_port":    0,
+		"backup":          false,
+		"admin_state_up":  true,
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"name":            "web-1",
+			"address":         "192.168.199.11",
+			"protocol_port":   8080,
+			"weight":          1,
+			"monitor_port":    0,
+			"monitor_address": "",
+			"subnet_id":       "subnet_1",
+			"backup":          false,
+			"admin_state_up":  true,
+		},
+		map[string]interface{}{
+			"name":            "web-2",
+			"address":         "192.168.199.12",
+			"protocol_port":   8080,
+			"weight":          1,
+			"monitor_port":    0,
+			"monitor_address": "",
+			"subnet_id":       "subnet_1",
+			"backup":          false,
+			"admin_state_up":  true,
+		},
+	}
+
+	computeClient := thclient.ServiceClient()
+	computeClient.Type = "compute"
+
+	actual, err := lbMembersV2SourceMembers(computeClient, thclient.ServiceClient(), source)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
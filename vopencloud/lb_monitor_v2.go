@@ -0,0 +1,190 @@
+package vopencloud
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	octaviapools "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/pools"
+)
+
+// lbV2MonitorHTTPArguments are the health monitor arguments, which are only
+// supported by the HTTP and HTTPS monitor types.
+var lbV2MonitorHTTPArguments = []string{"url_path", "http_method", "expected_codes", "http_version", "domain_name"}
+
+// lbV2MonitorUDPTypes are the health monitor types supported by UDP and SCTP
+// pools.
+var lbV2MonitorUDPTypes = []string{"UDP-CONNECT", "SCTP", "TCP", "HTTP"}
+
+// resourceMonitorV2CustomizeDiff rejects the health monitor arguments, which
+// are not supported by the monitor type, the parent pool protocol or the pool
+// members, at plan time.
+func resourceMonitorV2CustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	raw := diff.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() {
+		return nil
+	}
+
+	var args []string
+	for _, k := range lbV2MonitorHTTPArguments {
+		if v := raw.GetAttr(k); !v.IsNull() {
+			args = append(args, k)
+		}
+	}
+
+	monitorType := diff.Get("type").(string)
+	err := checkLBV2MonitorArguments(monitorType, args, diff.Get("http_version").(string))
+	if err != nil {
+		return err
+	}
+
+	config := meta.(*Config)
+	if !config.UseOctavia {
+		return checkLBV2OctaviaArguments(lbV2MonitorOctaviaArguments(args))
+	}
+
+	region := config.Region
+	if v, ok := diff.GetOk("region"); ok {
+		region = v.(string)
+	}
+
+	lbClient, err := config.LoadBalancerV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	var versionArgs []string
+	for _, k := range lbV2MonitorOctaviaArguments(args) {
+		if diff.Id() == "" || diff.HasChange(k) {
+			versionArgs = append(versionArgs, k)
+		}
+	}
+
+	if err := checkLBV2APIVersion(lbClient, versionArgs, lbV2MonitorAPIVersions); err != nil {
+		return err
+	}
+
+	// Check the parent pool only for new monitors, so that members added to
+	// the pool later don't block the plans of an existing monitor.
+	if diff.Id() != "" && !diff.HasChanges("type", "pool_id") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("pool_id") {
+		return nil
+	}
+
+	poolID := diff.Get("pool_id").(string)
+	if poolID == "" {
+		return nil
+	}
+
+	return checkLBV2MonitorPool(lbClient, poolID, monitorType)
+}
+
+// lbV2MonitorOctaviaArguments returns the set arguments, which are only
+// supported by Octavia.
+func lbV2MonitorOctaviaArguments(args []string) []string {
+	var res []string
+	for _, arg := range args {
+		if _, ok := lbV2MonitorAPIVersions[arg]; ok {
+			res = append(res, arg)
+		}
+	}
+
+	return res
+}
+
+// checkLBV2MonitorArguments verifies that the set arguments are supported by
+// the monitor type.
+func checkLBV2MonitorArguments(monitorType string, args []string, httpVersion string) error {
+	if monitorType != "HTTP" && monitorType != "HTTPS" && len(args) > 0 {
+		return fmt.Errorf("%s can only be set for HTTP and HTTPS monitors, got %s monitor type",
+			strings.Join(args, ", "), monitorType)
+	}
+
+	if strSliceContains(args, "domain_name") && httpVersion != "1.1" {
+		return fmt.Errorf("domain_name requires http_version to be set to 1.1")
+	}
+
+	return nil
+}
+
+// checkLBV2MonitorPool verifies that the monitor type is supported by the
+// parent pool protocol and that the pool members can be monitored. A missing
+// pool is ignored, because it may be replaced during the same apply.
+func checkLBV2MonitorPool(lbClient *gophercloud.ServiceClient, poolID string, monitorType string) error {
+	pool, err := octaviapools.Get(lbClient, poolID).Extract()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			return nil
+		}
+		return fmt.Errorf("Unable to retrieve parent openstack_lb_pool_v2 %s: %s", poolID, err)
+	}
+
+	if err := checkLBV2MonitorPoolProtocol(monitorType, pool.Protocol); err != nil {
+		return err
+	}
+
+	allPages, err := octaviapools.ListMembers(lbClient, poolID, octaviapools.ListMembersOpts{}).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to list openstack_lb_pool_v2 %s members: %s", poolID, err)
+	}
+
+	members, err := octaviapools.ExtractMembers(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to extract openstack_lb_pool_v2 %s members: %s", poolID, err)
+	}
+
+	return checkLBV2MonitorMembers(members, monitorType)
+}
+
+// checkLBV2MonitorPoolProtocol verifies that the monitor type is supported by
+// the pool protocol.
+func checkLBV2MonitorPoolProtocol(monitorType string, poolProtocol string) error {
+	udpPool := poolProtocol == "UDP" || poolProtocol == "SCTP"
+
+	switch {
+	case (monitorType == "UDP-CONNECT" || monitorType == "SCTP") && !udpPool:
+		return fmt.Errorf("%s monitors are only supported for UDP and SCTP pools, got %s pool", monitorType, poolProtocol)
+	case udpPool && !strSliceContains(lbV2MonitorUDPTypes, monitorType):
+		return fmt.Errorf("%s pools only support %s monitors, got %s monitor type",
+			poolProtocol, strings.Join(lbV2MonitorUDPTypes, ", "), monitorType)
+	}
+
+	return nil
+}
+
+// checkLBV2MonitorMembers verifies that the member monitor_address uses the
+// same IP version as the member address and that the member monitor_port can
+// be used by the monitor type.
+func checkLBV2MonitorMembers(members []octaviapools.Member, monitorType string) error {
+	for _, member := range members {
+		// PING monitors use ICMP, so that the monitor_port would be ignored.
+		if monitorType == "PING" && member.MonitorPort != 0 {
+			return fmt.Errorf("Member %s monitor_port %d can't be used by PING monitors",
+				member.ID, member.MonitorPort)
+		}
+
+		if member.MonitorAddress == "" {
+			continue
+		}
+
+		address := net.ParseIP(member.Address)
+		monitorAddress := net.ParseIP(member.MonitorAddress)
+		if address == nil || monitorAddress == nil {
+			continue
+		}
+
+		if (address.To4() == nil) != (monitorAddress.To4() == nil) {
+			return fmt.Errorf("Member %s monitor_address %s and address %s must use the same IP version",
+				member.ID, member.MonitorAddress, member.Address)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package vopencloud
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	octaviapools "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/pools"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestUnitCheckLBV2MonitorArguments(t *testing.T) {
+	assert.NoError(t, checkLBV2MonitorArguments("HTTP", []string{"url_path", "http_version"}, "1.0"))
+	assert.NoError(t, checkLBV2MonitorArguments("HTTPS", []string{"domain_name", "http_version"}, "1.1"))
+	assert.NoError(t, checkLBV2MonitorArguments("TCP", nil, ""))
+
+	assert.EqualError(t, checkLBV2MonitorArguments("TCP", []string{"url_path", "expected_codes"}, ""),
+		"url_path, expected_codes can only be set for HTTP and HTTPS monitors, got TCP monitor type")
+	assert.EqualError(t, checkLBV2MonitorArguments("HTTP", []string{"domain_name"}, ""),
+		"domain_name requires http_version to be set to 1.1")
+	assert.Error(t, checkLBV2MonitorArguments("HTTP", []string{"domain_name", "http_version"}, "1.0"))
+}
+
+func TestUnitCheckLBV2MonitorPoolProtocol(t *testing.T) {
+	assert.NoError(t, checkLBV2MonitorPoolProtocol("UDP-CONNECT", "UDP"))
+	assert.NoError(t, checkLBV2MonitorPoolProtocol("SCTP", "SCTP"))
+	assert.NoError(t, checkLBV2MonitorPoolProtocol("HTTP", "UDP"))
+	assert.NoError(t, checkLBV2MonitorPoolProtocol("TLS-HELLO", "HTTPS"))
+
+	assert.NoError(t, checkLBV2MonitorPoolProtocol("SCTP", "UDP"))
+	assert.NoError(t, checkLBV2MonitorPoolProtocol("UDP-CONNECT", "SCTP"))
+
+	assert.EqualError(t, checkLBV2MonitorPoolProtocol("UDP-CONNECT", "TCP"),
+		"UDP-CONNECT monitors are only supported for UDP and SCTP pools, got TCP pool")
+	assert.Error(t, checkLBV2MonitorPoolProtocol("SCTP", "HTTP"))
+	assert.Error(t, checkLBV2MonitorPoolProtocol("PING", "UDP"))
+	assert.Error(t, checkLBV2MonitorPoolProtocol("HTTPS", "SCTP"))
+}
+
+func TestUnitCheckLBV2MonitorPool(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/lbaas/pools/pool_1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"pool": {"id": "pool_1", "protocol": "UDP"}}`)
+	})
+
+	th.Mux.HandleFunc("/lbaas/pools/pool_1/members", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `
+{
+  "members": [
+    {"id": "member_1", "address": "192.168.199.10", "monitor_address": "192.168.199.20", "monitor_port": 8080},
+    {"id": "member_2", "address": "192.168.199.11", "monitor_address": "fd00::11"}
+  ]
+}`)
+	})
+
+	th.Mux.HandleFunc("/lbaas/pools/pool_2", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	client := thclient.ServiceClient()
+
+	assert.EqualError(t, checkLBV2MonitorPool(client, "pool_1", "UDP-CONNECT"),
+		"Member member_2 monitor_address fd00::11 and address 192.168.199.11 must use the same IP version")
+	assert.EqualError(t, checkLBV2MonitorPool(client, "pool_1", "PING"),
+		"UDP pools only support UDP-CONNECT, SCTP, TCP, HTTP monitors, got PING monitor type")
+	assert.NoError(t, checkLBV2MonitorPool(client, "pool_2", "PING"))
+}
+
+func TestUnitCheckLBV2MonitorMembers(t *testing.T) {
+	members := []octaviapools.Member{
+		{ID: "member_1", Address: "192.168.199.10", MonitorAddress: "192.168.199.20"},
+		{ID: "member_2", Address: "fd00::10", MonitorPort: 8080},
+	}
+
+	assert.NoError(t, checkLBV2MonitorMembers(members, "TCP"))
+	assert.EqualError(t, checkLBV2MonitorMembers(members, "PING"),
+		"Member member_2 monitor_port 8080 can't be used by PING monitors")
+	assert.NoError(t, checkLBV2MonitorMembers(members[:1], "PING"))
+}
+
+func TestUnitLBV2MonitorOctaviaArguments(t *testing.T) {
+	args := lbV2MonitorOctaviaArguments([]string{"url_path", "http_version", "domain_name"})
+	assert.Equal(t, []string{"http_version", "domain_name"}, args)
+	assert.EqualError(t, checkLBV2OctaviaArguments(args),
+		"http_version, domain_name can only be set when using octavia")
+
+	assert.Empty(t, lbV2MonitorOctaviaArguments([]string{"url_path", "expected_codes"}))
+	assert.NoError(t, checkLBV2OctaviaArguments(nil))
+}
@@ -4,12 +4,17 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/gophercloud/gophercloud"
+	octaviaamphorae "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/amphorae"
+	octaviaapiversions "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/apiversions"
+	octavial7policies "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/l7policies"
 	octavialisteners "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/listeners"
 	octavialoadbalancers "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
 	octaviamonitors "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/monitors"
@@ -32,6 +37,101 @@ const (
 	lbError         = "ERROR"
 )
 
+const (
+	// lbV2TLSAuthAPIVersion is the minimal Octavia API version, which supports
+	// listener client authentication and pool backend re-encryption.
+	lbV2TLSAuthAPIVersion     = "2.8"
+	lbV2TLSCiphersAPIVersion  = "2.15"
+	lbV2TLSVersionsAPIVersion = "2.17"
+	lbV2ALPNAPIVersion        = "2.20"
+	lbV2HSTSAPIVersion        = "2.27"
+
+	// lbV2AdditionalVipsAPIVersion is the minimal Octavia API version, which
+	// supports additional VIPs of a load balancer.
+	lbV2AdditionalVipsAPIVersion = "2.26"
+
+	// lbV2MonitorHTTPVersionAPIVersion is the minimal Octavia API version,
+	// which supports the HTTP version and domain name of a health monitor.
+	lbV2MonitorHTTPVersionAPIVersion = "2.10"
+
+	// lbV2L7PolicyRedirectAPIVersion is the minimal Octavia API version,
+	// which supports the L7 policy redirect prefix and HTTP code.
+	lbV2L7PolicyRedirectAPIVersion = "2.9"
+)
+
+// lbV2LoadBalancerAPIVersions maps the load balancer arguments to the minimal
+// Octavia API version supporting them.
+var lbV2LoadBalancerAPIVersions = map[string]string{
+	"additional_vips": lbV2AdditionalVipsAPIVersion,
+}
+
+// lbV2ListenerAPIVersions maps the listener arguments to the minimal Octavia
+// API version supporting them.
+var lbV2ListenerAPIVersions = map[string]string{
+	"client_authentication":       lbV2TLSAuthAPIVersion,
+	"client_ca_tls_container_ref": lbV2TLSAuthAPIVersion,
+	"client_crl_container_ref":    lbV2TLSAuthAPIVersion,
+	"tls_ciphers":                 lbV2TLSCiphersAPIVersion,
+	"tls_versions":                lbV2TLSVersionsAPIVersion,
+	"alpn_protocols":              lbV2ALPNAPIVersion,
+	"hsts_max_age":                lbV2HSTSAPIVersion,
+	"hsts_include_subdomains":     lbV2HSTSAPIVersion,
+	"hsts_preload":                lbV2HSTSAPIVersion,
+}
+
+// lbV2MonitorAPIVersions maps the health monitor arguments to the minimal
+// Octavia API version supporting them.
+var lbV2MonitorAPIVersions = map[string]string{
+	"http_version": lbV2MonitorHTTPVersionAPIVersion,
+	"domain_name":  lbV2MonitorHTTPVersionAPIVersion,
+}
+
+// lbV2L7PolicyAPIVersions maps the L7 policy arguments to the minimal Octavia
+// API version supporting them.
+var lbV2L7PolicyAPIVersions = map[string]string{
+	"redirect_prefix":    lbV2L7PolicyRedirectAPIVersion,
+	"redirect_http_code": lbV2L7PolicyRedirectAPIVersion,
+}
+
+// lbV2PoolAPIVersions maps the pool arguments to the minimal Octavia API
+// version supporting them.
+var lbV2PoolAPIVersions = map[string]string{
+	"tls_enabled":          lbV2TLSAuthAPIVersion,
+	"tls_container_ref":    lbV2TLSAuthAPIVersion,
+	"ca_tls_container_ref": lbV2TLSAuthAPIVersion,
+	"tls_versions":         lbV2TLSVersionsAPIVersion,
+}
+
+// lbV2LoadBalancerAdditionalVips represents the additional VIPs of an Octavia
+// load balancer.
+type lbV2LoadBalancerAdditionalVips struct {
+	AdditionalVips []LoadBalancerAdditionalVip `json:"additional_vips"`
+}
+
+// lbV2ListenerHSTS represents the HSTS settings of an Octavia listener.
+type lbV2ListenerHSTS struct {
+	MaxAge            *int `json:"hsts_max_age"`
+	IncludeSubdomains bool `json:"hsts_include_subdomains"`
+	Preload           bool `json:"hsts_preload"`
+}
+
+// lbV2MonitorDetails represents the Octavia health monitor attributes, which
+// are not part of the gophercloud monitor.
+type lbV2MonitorDetails struct {
+	HTTPVersion *float64 `json:"http_version"`
+	DomainName  string   `json:"domain_name"`
+	Tags        []string `json:"tags"`
+}
+
+// lbV2PoolTLS represents the backend re-encryption settings of an Octavia
+// pool.
+type lbV2PoolTLS struct {
+	TLSEnabled        bool     `json:"tls_enabled"`
+	TLSContainerRef   string   `json:"tls_container_ref"`
+	CATLSContainerRef string   `json:"ca_tls_container_ref"`
+	TLSVersions       []string `json:"tls_versions"`
+}
+
 // lbPendingStatuses are the valid statuses a LoadBalancer will be in while
 // it's updating.
 func getLbPendingStatuses() []string {
@@ -56,6 +156,16 @@ func chooseLBV2Client(d *schema.ResourceData, config *Config) (*gophercloud.Serv
 	return config.NetworkingV2Client(GetRegion(d, config))
 }
 
+// customizeDiffLBV2DefaultTags merges the provider-level default tags only
+// when Octavia is used, because Neutron LBaaS doesn't support tags.
+func customizeDiffLBV2DefaultTags(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if config, ok := meta.(*Config); !ok || !config.UseOctavia {
+		return nil
+	}
+
+	return customizeDiffObjectDefaultTags(ctx, diff, meta)
+}
+
 // chooseLBV2AccTestClient will determine which load balacing client to use:
 // either the Octavia/LBaaS client or the Neutron/Networking v2 client.
 // This is similar to the chooseLBV2Client function but specific for acceptance
@@ -74,7 +184,7 @@ func chooseLBV2LoadbalancerUpdateOpts(d *schema.ResourceData, config *Config) (n
 
 	if config.UseOctavia {
 		// Use Octavia.
-		var updateOpts octavialoadbalancers.UpdateOpts
+		var updateOpts LoadBalancerUpdateOpts
 
 		if d.HasChange("name") {
 			hasChange = true
@@ -92,15 +202,16 @@ func chooseLBV2LoadbalancerUpdateOpts(d *schema.ResourceData, config *Config) (n
 			updateOpts.AdminStateUp = &asu
 		}
 
-		if d.HasChange("tags") {
+		if d.HasChanges("tags", "all_tags") {
 			hasChange = true
-			if v, ok := d.GetOk("tags"); ok {
-				tags := v.(*schema.Set).List()
-				tagsToUpdate := expandToStringSlice(tags)
-				updateOpts.Tags = &tagsToUpdate
-			} else {
-				updateOpts.Tags = &[]string{}
-			}
+			tags := expandObjectUpdateTags(d, config.DefaultTags)
+			updateOpts.Tags = &tags
+		}
+
+		if d.HasChange("vip_qos_policy_id") {
+			hasChange = true
+			vipQosPolicyID := d.Get("vip_qos_policy_id").(string)
+			updateOpts.VipQosPolicyID = &vipQosPolicyID
 		}
 
 		if hasChange {
@@ -134,6 +245,94 @@ func chooseLBV2LoadbalancerUpdateOpts(d *schema.ResourceData, config *Config) (n
 	return nil, nil
 }
 
+// lbV2MaxAPIVersion returns the latest API version supported by Octavia,
+// e.g. "2.27".
+func lbV2MaxAPIVersion(lbClient *gophercloud.ServiceClient) (string, error) {
+	allPages, err := octaviaapiversions.List(lbClient).AllPages()
+	if err != nil {
+		return "", err
+	}
+
+	versions, err := octaviaapiversions.ExtractAPIVersions(allPages)
+	if err != nil {
+		return "", err
+	}
+
+	var maxVersion string
+	for _, v := range versions {
+		version := strings.TrimPrefix(v.ID, "v")
+		if maxVersion == "" {
+			maxVersion = version
+			continue
+		}
+
+		newer, err := compatibleMicroversion("min", maxVersion, version)
+		if err != nil {
+			return "", err
+		}
+		if newer {
+			maxVersion = version
+		}
+	}
+
+	if maxVersion == "" {
+		return "", fmt.Errorf("Octavia didn't report any API versions")
+	}
+
+	return maxVersion, nil
+}
+
+// lbV2ChangedAPIVersionArguments returns the sorted list of set and changed
+// arguments, which require a specific Octavia API version.
+func lbV2ChangedAPIVersionArguments(d *schema.ResourceData, apiVersions map[string]string) []string {
+	var args []string
+	for arg := range apiVersions {
+		if _, ok := d.GetOk(arg); ok && d.HasChange(arg) {
+			args = append(args, arg)
+		}
+	}
+	sort.Strings(args)
+
+	return args
+}
+
+// checkLBV2OctaviaArguments returns an error, when one of the given
+// arguments, which are only supported by Octavia, is set while Neutron LBaaS
+// is used.
+func checkLBV2OctaviaArguments(args []string) error {
+	if len(args) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%s can only be set when using octavia", strings.Join(args, ", "))
+}
+
+// checkLBV2APIVersion returns an error, when one of the given arguments
+// isn't supported by the Octavia API version reported by the service.
+func checkLBV2APIVersion(lbClient *gophercloud.ServiceClient, args []string, apiVersions map[string]string) error {
+	if len(args) == 0 {
+		return nil
+	}
+
+	maxVersion, err := lbV2MaxAPIVersion(lbClient)
+	if err != nil {
+		return fmt.Errorf("Unable to get Octavia API version: %s", err)
+	}
+
+	for _, arg := range args {
+		supported, err := compatibleMicroversion("min", apiVersions[arg], maxVersion)
+		if err != nil {
+			return err
+		}
+		if !supported {
+			return fmt.Errorf("%s requires Octavia API version %s, but the service supports only %s",
+				arg, apiVersions[arg], maxVersion)
+		}
+	}
+
+	return nil
+}
+
 // chooseLBV2ListenerCreateOpts will determine which load balancer listener Create options to use:
 // either the Octavia/LBaaS or the Neutron/Networking v2.
 func chooseLBV2ListenerCreateOpts(d *schema.ResourceData, config *Config) (neutronlisteners.CreateOptsBuilder, error) {
@@ -189,9 +388,8 @@ func chooseLBV2ListenerCreateOpts(d *schema.ResourceData, config *Config) (neutr
 			opts.TimeoutTCPInspect = &timeoutTCPInspect
 		}
 
-		if v, ok := d.GetOk("tags"); ok {
-			tags := v.(*schema.Set).List()
-			opts.Tags = expandToStringSlice(tags)
+		if tags := expandObjectCreateTags(d, config.DefaultTags); len(tags) > 0 {
+			opts.Tags = tags
 		}
 
 		// Get and check insert  headers map.
@@ -211,7 +409,32 @@ func chooseLBV2ListenerCreateOpts(d *schema.ResourceData, config *Config) (neutr
 			opts.AllowedCIDRs = allowedCidrs
 		}
 
-		createOpts = opts
+		if raw, ok := d.GetOk("tls_versions"); ok {
+			for _, v := range raw.(*schema.Set).List() {
+				opts.TLSVersions = append(opts.TLSVersions, octavialisteners.TLSVersion(v.(string)))
+			}
+		}
+
+		tlsOpts := ListenerCreateOpts{
+			CreateOpts:              opts,
+			TLSCiphers:              d.Get("tls_ciphers").(string),
+			ClientAuthentication:    d.Get("client_authentication").(string),
+			ClientCATLSContainerRef: d.Get("client_ca_tls_container_ref").(string),
+			ClientCRLContainerRef:   d.Get("client_crl_container_ref").(string),
+			HSTSIncludeSubdomains:   d.Get("hsts_include_subdomains").(bool),
+			HSTSPreload:             d.Get("hsts_preload").(bool),
+		}
+
+		if raw, ok := d.GetOk("alpn_protocols"); ok {
+			tlsOpts.ALPNProtocols = expandToStringSlice(raw.(*schema.Set).List())
+		}
+
+		if v, ok := d.GetOk("hsts_max_age"); ok {
+			hstsMaxAge := v.(int)
+			tlsOpts.HSTSMaxAge = &hstsMaxAge
+		}
+
+		createOpts = tlsOpts
 
 		return createOpts, nil
 	}
@@ -343,19 +566,73 @@ func chooseLBV2ListenerUpdateOpts(d *schema.ResourceData, config *Config) (neutr
 			opts.AllowedCIDRs = &allowedCidrs
 		}
 
-		if d.HasChange("tags") {
+		if d.HasChanges("tags", "all_tags") {
 			hasChange = true
-			if v, ok := d.GetOk("tags"); ok {
-				tags := v.(*schema.Set).List()
-				tagsToUpdate := expandToStringSlice(tags)
-				opts.Tags = &tagsToUpdate
-			} else {
-				opts.Tags = &[]string{}
+			tags := expandObjectUpdateTags(d, config.DefaultTags)
+			opts.Tags = &tags
+		}
+
+		if d.HasChange("tls_versions") {
+			hasChange = true
+			tlsVersions := []octavialisteners.TLSVersion{}
+			for _, v := range d.Get("tls_versions").(*schema.Set).List() {
+				tlsVersions = append(tlsVersions, octavialisteners.TLSVersion(v.(string)))
 			}
+			opts.TLSVersions = &tlsVersions
+		}
+
+		tlsOpts := ListenerUpdateOpts{UpdateOpts: opts}
+
+		if d.HasChange("tls_ciphers") {
+			hasChange = true
+			tlsCiphers := d.Get("tls_ciphers").(string)
+			tlsOpts.TLSCiphers = &tlsCiphers
+		}
+
+		if d.HasChange("alpn_protocols") {
+			hasChange = true
+			alpnProtocols := expandToStringSlice(d.Get("alpn_protocols").(*schema.Set).List())
+			tlsOpts.ALPNProtocols = &alpnProtocols
+		}
+
+		if d.HasChange("client_authentication") {
+			hasChange = true
+			clientAuthentication := d.Get("client_authentication").(string)
+			tlsOpts.ClientAuthentication = &clientAuthentication
+		}
+
+		if d.HasChange("client_ca_tls_container_ref") {
+			hasChange = true
+			clientCATLSContainerRef := d.Get("client_ca_tls_container_ref").(string)
+			tlsOpts.ClientCATLSContainerRef = &clientCATLSContainerRef
+		}
+
+		if d.HasChange("client_crl_container_ref") {
+			hasChange = true
+			clientCRLContainerRef := d.Get("client_crl_container_ref").(string)
+			tlsOpts.ClientCRLContainerRef = &clientCRLContainerRef
+		}
+
+		if d.HasChange("hsts_max_age") {
+			hasChange = true
+			hstsMaxAge := d.Get("hsts_max_age").(int)
+			tlsOpts.HSTSMaxAge = &hstsMaxAge
+		}
+
+		if d.HasChange("hsts_include_subdomains") {
+			hasChange = true
+			hstsIncludeSubdomains := d.Get("hsts_include_subdomains").(bool)
+			tlsOpts.HSTSIncludeSubdomains = &hstsIncludeSubdomains
+		}
+
+		if d.HasChange("hsts_preload") {
+			hasChange = true
+			hstsPreload := d.Get("hsts_preload").(bool)
+			tlsOpts.HSTSPreload = &hstsPreload
 		}
 
 		if hasChange {
-			return opts, nil
+			return tlsOpts, nil
 		}
 	}
 
@@ -484,6 +761,120 @@ func resourceLBV2ListenerRefreshFunc(lbClient *gophercloud.ServiceClient, lbID s
 	return resourceLBV2LoadBalancerStatusRefreshFuncNeutron(lbClient, lbID, "listener", listener.ID, "")
 }
 
+// chooseLBV2L7PolicyCreateOpts will determine which L7 policy Create options
+// to use: either the Octavia/LBaaS or the Neutron/Networking v2.
+func chooseLBV2L7PolicyCreateOpts(d *schema.ResourceData, config *Config) neutronl7policies.CreateOptsBuilder {
+	adminStateUp := d.Get("admin_state_up").(bool)
+	position := int32(d.Get("position").(int))
+
+	if config.UseOctavia {
+		// Use Octavia.
+		return octavial7policies.CreateOpts{
+			ProjectID:        d.Get("tenant_id").(string),
+			Name:             d.Get("name").(string),
+			Description:      d.Get("description").(string),
+			Action:           octavial7policies.Action(strings.ToUpper(d.Get("action").(string))),
+			ListenerID:       d.Get("listener_id").(string),
+			Position:         position,
+			RedirectPoolID:   d.Get("redirect_pool_id").(string),
+			RedirectURL:      d.Get("redirect_url").(string),
+			RedirectPrefix:   d.Get("redirect_prefix").(string),
+			RedirectHttpCode: int32(d.Get("redirect_http_code").(int)),
+			AdminStateUp:     &adminStateUp,
+		}
+	}
+
+	// Use Neutron.
+	return neutronl7policies.CreateOpts{
+		TenantID:       d.Get("tenant_id").(string),
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		Action:         neutronl7policies.Action(d.Get("action").(string)),
+		ListenerID:     d.Get("listener_id").(string),
+		Position:       position,
+		RedirectPoolID: d.Get("redirect_pool_id").(string),
+		RedirectURL:    d.Get("redirect_url").(string),
+		AdminStateUp:   &adminStateUp,
+	}
+}
+
+// chooseLBV2L7PolicyUpdateOpts will determine which L7 policy Update options
+// to use: either the Octavia/LBaaS or the Neutron/Networking v2.
+func chooseLBV2L7PolicyUpdateOpts(d *schema.ResourceData, config *Config) neutronl7policies.UpdateOptsBuilder {
+	if config.UseOctavia {
+		// Use Octavia.
+		var opts octavial7policies.UpdateOpts
+
+		if d.HasChange("action") {
+			opts.Action = octavial7policies.Action(strings.ToUpper(d.Get("action").(string)))
+		}
+		if d.HasChange("name") {
+			name := d.Get("name").(string)
+			opts.Name = &name
+		}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			opts.Description = &description
+		}
+		if d.HasChange("redirect_pool_id") {
+			redirectPoolID := d.Get("redirect_pool_id").(string)
+			opts.RedirectPoolID = &redirectPoolID
+		}
+		if d.HasChange("redirect_url") {
+			redirectURL := d.Get("redirect_url").(string)
+			opts.RedirectURL = &redirectURL
+		}
+		if d.HasChange("redirect_prefix") {
+			redirectPrefix := d.Get("redirect_prefix").(string)
+			opts.RedirectPrefix = &redirectPrefix
+		}
+		if d.HasChange("redirect_http_code") {
+			opts.RedirectHttpCode = int32(d.Get("redirect_http_code").(int))
+		}
+		if d.HasChange("position") {
+			opts.Position = int32(d.Get("position").(int))
+		}
+		if d.HasChange("admin_state_up") {
+			adminStateUp := d.Get("admin_state_up").(bool)
+			opts.AdminStateUp = &adminStateUp
+		}
+
+		return opts
+	}
+
+	// Use Neutron.
+	var opts neutronl7policies.UpdateOpts
+
+	if d.HasChange("action") {
+		opts.Action = neutronl7policies.Action(d.Get("action").(string))
+	}
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		opts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		opts.Description = &description
+	}
+	if d.HasChange("redirect_pool_id") {
+		redirectPoolID := d.Get("redirect_pool_id").(string)
+		opts.RedirectPoolID = &redirectPoolID
+	}
+	if d.HasChange("redirect_url") {
+		redirectURL := d.Get("redirect_url").(string)
+		opts.RedirectURL = &redirectURL
+	}
+	if d.HasChange("position") {
+		opts.Position = int32(d.Get("position").(int))
+	}
+	if d.HasChange("admin_state_up") {
+		adminStateUp := d.Get("admin_state_up").(bool)
+		opts.AdminStateUp = &adminStateUp
+	}
+
+	return opts
+}
+
 // chooseLBV2MonitorCreateOpts will determine which load balancer monitor Create options to use:
 // either the Octavia/LBaaS or the Neutron/Networking v2.
 func chooseLBV2MonitorCreateOpts(d *schema.ResourceData, config *Config) neutronmonitors.CreateOptsBuilder {
@@ -493,19 +884,24 @@ func chooseLBV2MonitorCreateOpts(d *schema.ResourceData, config *Config) neutron
 
 	if config.UseOctavia {
 		// Use Octavia.
-		opts := octaviamonitors.CreateOpts{
-			PoolID:         d.Get("pool_id").(string),
-			TenantID:       d.Get("tenant_id").(string),
-			Type:           d.Get("type").(string),
-			Delay:          d.Get("delay").(int),
-			Timeout:        d.Get("timeout").(int),
-			MaxRetries:     d.Get("max_retries").(int),
-			MaxRetriesDown: d.Get("max_retries_down").(int),
-			URLPath:        d.Get("url_path").(string),
-			HTTPMethod:     d.Get("http_method").(string),
-			ExpectedCodes:  d.Get("expected_codes").(string),
-			Name:           d.Get("name").(string),
-			AdminStateUp:   &adminStateUp,
+		opts := MonitorCreateOpts{
+			CreateOpts: octaviamonitors.CreateOpts{
+				PoolID:         d.Get("pool_id").(string),
+				TenantID:       d.Get("tenant_id").(string),
+				Type:           d.Get("type").(string),
+				Delay:          d.Get("delay").(int),
+				Timeout:        d.Get("timeout").(int),
+				MaxRetries:     d.Get("max_retries").(int),
+				MaxRetriesDown: d.Get("max_retries_down").(int),
+				URLPath:        d.Get("url_path").(string),
+				HTTPMethod:     d.Get("http_method").(string),
+				ExpectedCodes:  d.Get("expected_codes").(string),
+				Name:           d.Get("name").(string),
+				AdminStateUp:   &adminStateUp,
+			},
+			HTTPVersion: d.Get("http_version").(string),
+			DomainName:  d.Get("domain_name").(string),
+			Tags:        expandObjectCreateTags(d, config.DefaultTags),
 		}
 
 		createOpts = opts
@@ -538,7 +934,7 @@ func chooseLBV2MonitorUpdateOpts(d *schema.ResourceData, config *Config) neutron
 
 	if config.UseOctavia {
 		// Use Octavia.
-		var opts octaviamonitors.UpdateOpts
+		var opts MonitorUpdateOpts
 
 		if d.HasChange("url_path") {
 			hasChange = true
@@ -578,6 +974,21 @@ func chooseLBV2MonitorUpdateOpts(d *schema.ResourceData, config *Config) neutron
 			hasChange = true
 			opts.HTTPMethod = d.Get("http_method").(string)
 		}
+		if d.HasChange("http_version") {
+			hasChange = true
+			httpVersion := d.Get("http_version").(string)
+			opts.HTTPVersion = &httpVersion
+		}
+		if d.HasChange("domain_name") {
+			hasChange = true
+			domainName := d.Get("domain_name").(string)
+			opts.DomainName = &domainName
+		}
+		if d.HasChanges("tags", "all_tags") {
+			hasChange = true
+			tags := expandObjectUpdateTags(d, config.DefaultTags)
+			opts.Tags = &tags
+		}
 
 		if hasChange {
 			return opts
@@ -1255,3 +1666,189 @@ func resourceLoadBalancerV2GetSecurityGroups(networkingClient *gophercloud.Servi
 
 	return nil
 }
+
+// lbV2MatchTags returns true, when all the filter tags are set on a load
+// balancer object. It is used for the objects, which can't be filtered by
+// tags through the API.
+func lbV2MatchTags(filter []string, tags []string) bool {
+	for _, tag := range filter {
+		if !strSliceContains(tags, tag) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenLBAmphoraeV2(in []octaviaamphorae.Amphora) []map[string]interface{} {
+	out := make([]map[string]interface{}, len(in))
+	for i, a := range in {
+		out[i] = map[string]interface{}{
+			"id":            a.ID,
+			"role":          a.Role,
+			"status":        a.Status,
+			"compute_id":    a.ComputeID,
+			"image_id":      a.ImageID,
+			"cached_zone":   a.CachedZone,
+			"lb_network_ip": a.LBNetworkIP,
+			"ha_ip":         a.HAIP,
+			"ha_port_id":    a.HAPortID,
+			"vrrp_ip":       a.VRRPIP,
+			"vrrp_port_id":  a.VRRPPortID,
+		}
+	}
+
+	return out
+}
+
+// lbV2LoadBalancerStatusTree represents the root of a load balancer status tree.
+type lbV2LoadBalancerStatusTree struct {
+	ID                 string                   `json:"id"`
+	Name               string                   `json:"name"`
+	ProvisioningStatus string                   `json:"provisioning_status"`
+	OperatingStatus    string                   `json:"operating_status"`
+	Listeners          []lbV2ListenerStatusTree `json:"listeners"`
+}
+
+type lbV2ListenerStatusTree struct {
+	ID                 string               `json:"id"`
+	Name               string               `json:"name"`
+	ProvisioningStatus string               `json:"provisioning_status"`
+	OperatingStatus    string               `json:"operating_status"`
+	Pools              []lbV2PoolStatusTree `json:"pools"`
+}
+
+type lbV2PoolStatusTree struct {
+	ID                 string                 `json:"id"`
+	Name               string                 `json:"name"`
+	ProvisioningStatus string                 `json:"provisioning_status"`
+	OperatingStatus    string                 `json:"operating_status"`
+	Members            []lbV2MemberStatusTree `json:"members"`
+	HealthMonitor      *lbV2MonitorStatusTree `json:"health_monitor"`
+	// Neutron LBaaS uses a different key for the health monitor.
+	NeutronHealthMonitor *lbV2MonitorStatusTree `json:"healthmonitor"`
+}
+
+type lbV2MemberStatusTree struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Address            string `json:"address"`
+	ProtocolPort       int    `json:"protocol_port"`
+	ProvisioningStatus string `json:"provisioning_status"`
+	OperatingStatus    string `json:"operating_status"`
+}
+
+type lbV2MonitorStatusTree struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Type               string `json:"type"`
+	ProvisioningStatus string `json:"provisioning_status"`
+	OperatingStatus    string `json:"operating_status"`
+}
+
+func lbV2GetLoadBalancerStatusTree(lbClient *gophercloud.ServiceClient, lbID string) (*lbV2LoadBalancerStatusTree, error) {
+	var s struct {
+		Statuses struct {
+			Loadbalancer *lbV2LoadBalancerStatusTree `json:"loadbalancer"`
+		} `json:"statuses"`
+	}
+
+	var err error
+	if lbClient.Type == octaviaLBClientType {
+		err = octavialoadbalancers.GetStatuses(lbClient, lbID).ExtractInto(&s)
+	} else {
+		err = neutronloadbalancers.GetStatuses(lbClient, lbID).ExtractInto(&s)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Don't fail, when statuses returns "null"
+	if s.Statuses.Loadbalancer == nil {
+		return &lbV2LoadBalancerStatusTree{ID: lbID}, nil
+	}
+
+	return s.Statuses.Loadbalancer, nil
+}
+
+func flattenLBV2ListenersStatusTree(listeners []lbV2ListenerStatusTree) []map[string]interface{} {
+	out := make([]map[string]interface{}, len(listeners))
+	for i, listener := range listeners {
+		pools := make([]map[string]interface{}, len(listener.Pools))
+		for j, pool := range listener.Pools {
+			members := make([]map[string]interface{}, len(pool.Members))
+			for k, member := range pool.Members {
+				members[k] = map[string]interface{}{
+					"id":                  member.ID,
+					"name":                member.Name,
+					"address":             member.Address,
+					"protocol_port":       member.ProtocolPort,
+					"provisioning_status": member.ProvisioningStatus,
+					"operating_status":    member.OperatingStatus,
+				}
+			}
+
+			monitor := pool.HealthMonitor
+			if monitor == nil {
+				monitor = pool.NeutronHealthMonitor
+			}
+
+			var monitors []map[string]interface{}
+			if monitor != nil && monitor.ID != "" {
+				monitors = []map[string]interface{}{
+					{
+						"id":                  monitor.ID,
+						"name":                monitor.Name,
+						"type":                monitor.Type,
+						"provisioning_status": monitor.ProvisioningStatus,
+						"operating_status":    monitor.OperatingStatus,
+					},
+				}
+			}
+
+			pools[j] = map[string]interface{}{
+				"id":                  pool.ID,
+				"name":                pool.Name,
+				"provisioning_status": pool.ProvisioningStatus,
+				"operating_status":    pool.OperatingStatus,
+				"members":             members,
+				"health_monitor":      monitors,
+			}
+		}
+
+		out[i] = map[string]interface{}{
+			"id":                  listener.ID,
+			"name":                listener.Name,
+			"provisioning_status": listener.ProvisioningStatus,
+			"operating_status":    listener.OperatingStatus,
+			"pools":               pools,
+		}
+	}
+
+	return out
+}
+
+func expandLBV2AdditionalVips(raw []interface{}) []LoadBalancerAdditionalVip {
+	vips := make([]LoadBalancerAdditionalVip, 0, len(raw))
+	for _, v := range raw {
+		vip := v.(map[string]interface{})
+		vips = append(vips, LoadBalancerAdditionalVip{
+			SubnetID:  vip["subnet_id"].(string),
+			IPAddress: vip["ip_address"].(string),
+		})
+	}
+
+	return vips
+}
+
+func flattenLBV2AdditionalVips(vips []LoadBalancerAdditionalVip) []map[string]interface{} {
+	out := make([]map[string]interface{}, len(vips))
+	for i, vip := range vips {
+		out[i] = map[string]interface{}{
+			"subnet_id":  vip.SubnetID,
+			"ip_address": vip.IPAddress,
+		}
+	}
+
+	return out
+}
@@ -1,9 +1,17 @@
 package vopencloud
 
 import (
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	octaviaamphorae "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/amphorae"
+	octavialoadbalancers "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
+	octaviamonitors "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/monitors"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
 )
 
 func TestUnitExpandLBV2ListenerHeadersMap(t *testing.T) {
@@ -34,3 +42,378 @@ func TestUnitExpandLBV2ListenerHeadersMap_err(t *testing.T) {
 	assert.Error(t, err)
 	assert.Empty(t, actual)
 }
+
+func TestUnitLBV2MatchTags(t *testing.T) {
+	tags := []string{"foo", "bar"}
+
+	assert.True(t, lbV2MatchTags(nil, tags))
+	assert.True(t, lbV2MatchTags([]string{"foo"}, tags))
+	assert.True(t, lbV2MatchTags([]string{"bar", "foo"}, tags))
+	assert.False(t, lbV2MatchTags([]string{"foo", "baz"}, tags))
+	assert.False(t, lbV2MatchTags([]string{"foo"}, nil))
+}
+
+func testLBV2HandleAPIVersions(t *testing.T) {
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `
+{
+  "versions": [
+    {
+      "id": "v2.0",
+      "status": "SUPPORTED"
+    },
+    {
+      "id": "v2.9",
+      "status": "SUPPORTED"
+    },
+    {
+      "id": "v2.17",
+      "status": "CURRENT"
+    }
+  ]
+}
+`)
+	})
+}
+
+func TestUnitLBV2MaxAPIVersion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	testLBV2HandleAPIVersions(t)
+
+	actual, err := lbV2MaxAPIVersion(thclient.ServiceClient())
+	assert.NoError(t, err)
+	assert.Equal(t, "2.17", actual)
+}
+
+func TestUnitCheckLBV2APIVersion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	testLBV2HandleAPIVersions(t)
+
+	client := thclient.ServiceClient()
+
+	assert.NoError(t, checkLBV2APIVersion(client, nil, lbV2ListenerAPIVersions))
+	assert.NoError(t, checkLBV2APIVersion(client, []string{"client_authentication", "tls_versions"}, lbV2ListenerAPIVersions))
+
+	err := checkLBV2APIVersion(client, []string{"alpn_protocols", "tls_versions"}, lbV2ListenerAPIVersions)
+	assert.EqualError(t, err, "alpn_protocols requires Octavia API version 2.20, but the service supports only 2.17")
+}
+
+func TestUnitListenerUpdateOpts(t *testing.T) {
+	empty := ""
+	maxAge := 0
+	opts := ListenerUpdateOpts{
+		ClientCATLSContainerRef: &empty,
+		HSTSMaxAge:              &maxAge,
+	}
+
+	expected := map[string]interface{}{
+		"listener": map[string]interface{}{
+			"client_ca_tls_container_ref": nil,
+			"hsts_max_age":                nil,
+		},
+	}
+
+	actual, err := opts.ToListenerUpdateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnitLoadBalancerCreateOpts(t *testing.T) {
+	opts := LoadBalancerCreateOpts{
+		CreateOpts: octavialoadbalancers.CreateOpts{
+			VipSubnetID:    "subnet_1",
+			VipQosPolicyID: "policy_1",
+		},
+		AdditionalVips: []LoadBalancerAdditionalVip{
+			{
+				SubnetID: "subnet_2",
+			},
+			{
+				SubnetID:  "subnet_3",
+				IPAddress: "fd00::10",
+			},
+		},
+	}
+
+	expected := map[string]interface{}{
+		"loadbalancer": map[string]interface{}{
+			"vip_subnet_id":     "subnet_1",
+			"vip_qos_policy_id": "policy_1",
+			"additional_vips": []interface{}{
+				map[string]interface{}{
+					"subnet_id": "subnet_2",
+				},
+				map[string]interface{}{
+					"subnet_id":  "subnet_3",
+					"ip_address": "fd00::10",
+				},
+			},
+		},
+	}
+
+	actual, err := opts.ToLoadBalancerCreateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnitLoadBalancerUpdateOpts(t *testing.T) {
+	empty := ""
+	opts := LoadBalancerUpdateOpts{
+		UpdateOpts: octavialoadbalancers.UpdateOpts{
+			VipQosPolicyID: &empty,
+		},
+	}
+
+	expected := map[string]interface{}{
+		"loadbalancer": map[string]interface{}{
+			"vip_qos_policy_id": nil,
+		},
+	}
+
+	actual, err := opts.ToLoadBalancerUpdateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnitMonitorCreateOpts(t *testing.T) {
+	opts := MonitorCreateOpts{
+		CreateOpts: octaviamonitors.CreateOpts{
+			PoolID:     "pool_1",
+			Type:       "HTTP",
+			Delay:      20,
+			Timeout:    10,
+			MaxRetries: 5,
+		},
+		HTTPVersion: "1.1",
+		DomainName:  "example.com",
+		Tags:        []string{"foo"},
+	}
+
+	expected := map[string]interface{}{
+		"healthmonitor": map[string]interface{}{
+			"pool_id":      "pool_1",
+			"type":         "HTTP",
+			"delay":        float64(20),
+			"timeout":      float64(10),
+			"max_retries":  float64(5),
+			"http_version": 1.1,
+			"domain_name":  "example.com",
+			"tags":         []interface{}{"foo"},
+		},
+	}
+
+	actual, err := opts.ToMonitorCreateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnitMonitorUpdateOpts(t *testing.T) {
+	empty := ""
+	opts := MonitorUpdateOpts{
+		HTTPVersion: &empty,
+		DomainName:  &empty,
+	}
+
+	expected := map[string]interface{}{
+		"healthmonitor": map[string]interface{}{
+			"http_version": nil,
+			"domain_name":  nil,
+		},
+	}
+
+	actual, err := opts.ToMonitorUpdateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	version := "2.0"
+	opts = MonitorUpdateOpts{
+		HTTPVersion: &version,
+	}
+
+	_, err = opts.ToMonitorUpdateMap()
+	assert.NoError(t, err)
+
+	version = "invalid"
+	_, err = opts.ToMonitorUpdateMap()
+	assert.Error(t, err)
+}
+
+func TestUnitCheckL7PolicyAction(t *testing.T) {
+	assert.NoError(t, checkL7PolicyAction("REJECT", "", "", "", 0))
+	assert.NoError(t, checkL7PolicyAction("REDIRECT_TO_POOL", "", "pool_1", "", 0))
+	assert.NoError(t, checkL7PolicyAction("REDIRECT_TO_URL", "http://www.example.com", "", "", 301))
+	assert.NoError(t, checkL7PolicyAction("redirect_prefix", "", "", "https://www.example.com", 308))
+
+	assert.Error(t, checkL7PolicyAction("REJECT", "", "", "https://www.example.com", 0))
+	assert.Error(t, checkL7PolicyAction("REDIRECT_TO_POOL", "", "pool_1", "https://www.example.com", 0))
+	assert.Error(t, checkL7PolicyAction("REDIRECT_TO_URL", "http://www.example.com", "", "https://www.example.com", 0))
+	assert.Error(t, checkL7PolicyAction("REDIRECT_PREFIX", "http://www.example.com", "", "https://www.example.com", 0))
+	assert.EqualError(t, checkL7PolicyAction("REDIRECT_TO_POOL", "", "pool_1", "", 301),
+		"redirect_http_code can only be set when action is set to REDIRECT_TO_URL or REDIRECT_PREFIX")
+}
+
+func TestUnitPoolUpdateOpts(t *testing.T) {
+	enabled := true
+	empty := ""
+	opts := PoolUpdateOpts{
+		TLSEnabled:      &enabled,
+		TLSContainerRef: &empty,
+	}
+
+	expected := map[string]interface{}{
+		"pool": map[string]interface{}{
+			"tls_enabled":       true,
+			"tls_container_ref": nil,
+		},
+	}
+
+	actual, err := opts.ToPoolUpdateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnitFlattenLBAmphoraeV2(t *testing.T) {
+	amphorae := []octaviaamphorae.Amphora{
+		{
+			ID:          "45f40289-0551-483a-b089-47214bc2a8a4",
+			ComputeID:   "053bd5a6-8a1d-4bd6-a1d5-5b5d8c1d7f36",
+			Role:        "MASTER",
+			Status:      "ALLOCATED",
+			LBNetworkIP: "192.168.0.6",
+			HAIP:        "10.0.0.6",
+			HAPortID:    "e8a1b6f0-b3c6-4a52-9cbb-7b5e3d02c0d5",
+			VRRPIP:      "10.0.0.4",
+			VRRPPortID:  "7f5d3b0e-7b5a-4bfb-9b1d-9b6e5b1a0b2e",
+			ImageID:     "5d1aed06-2624-43f5-a413-9212263c3d53",
+			CachedZone:  "nova",
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"id":            "45f40289-0551-483a-b089-47214bc2a8a4",
+			"role":          "MASTER",
+			"status":        "ALLOCATED",
+			"compute_id":    "053bd5a6-8a1d-4bd6-a1d5-5b5d8c1d7f36",
+			"image_id":      "5d1aed06-2624-43f5-a413-9212263c3d53",
+			"cached_zone":   "nova",
+			"lb_network_ip": "192.168.0.6",
+			"ha_ip":         "10.0.0.6",
+			"ha_port_id":    "e8a1b6f0-b3c6-4a52-9cbb-7b5e3d02c0d5",
+			"vrrp_ip":       "10.0.0.4",
+			"vrrp_port_id":  "7f5d3b0e-7b5a-4bfb-9b1d-9b6e5b1a0b2e",
+		},
+	}
+
+	assert.Equal(t, expected, flattenLBAmphoraeV2(amphorae))
+}
+
+func TestUnitLBV2GetLoadBalancerStatusTree(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/lbaas/loadbalancers/lb_1/status", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `
+{
+  "statuses": {
+    "loadbalancer": {
+      "id": "lb_1",
+      "name": "loadbalancer_1",
+      "provisioning_status": "ACTIVE",
+      "operating_status": "DEGRADED",
+      "listeners": [
+        {
+          "id": "listener_1",
+          "name": "listener_1",
+          "provisioning_status": "ACTIVE",
+          "operating_status": "ONLINE",
+          "pools": [
+            {
+              "id": "pool_1",
+              "name": "pool_1",
+              "provisioning_status": "ACTIVE",
+              "operating_status": "DEGRADED",
+              "health_monitor": {
+                "id": "monitor_1",
+                "name": "monitor_1",
+                "type": "HTTP",
+                "provisioning_status": "ACTIVE",
+                "operating_status": "ONLINE"
+              },
+              "members": [
+                {
+                  "id": "member_1",
+                  "name": "member_1",
+                  "address": "192.168.199.23",
+                  "protocol_port": 8080,
+                  "provisioning_status": "ACTIVE",
+                  "operating_status": "ERROR"
+                }
+              ]
+            }
+          ]
+        }
+      ]
+    }
+  }
+}
+`)
+	})
+
+	client := thclient.ServiceClient()
+	client.Type = octaviaLBClientType
+
+	actual, err := lbV2GetLoadBalancerStatusTree(client, "lb_1")
+	assert.NoError(t, err)
+	assert.Equal(t, "DEGRADED", actual.OperatingStatus)
+
+	expected := []map[string]interface{}{
+		{
+			"id":                  "listener_1",
+			"name":                "listener_1",
+			"provisioning_status": "ACTIVE",
+			"operating_status":    "ONLINE",
+			"pools": []map[string]interface{}{
+				{
+					"id":                  "pool_1",
+					"name":                "pool_1",
+					"provisioning_status": "ACTIVE",
+					"operating_status":    "DEGRADED",
+					"members": []map[string]interface{}{
+						{
+							"id":                  "member_1",
+							"name":                "member_1",
+							"address":             "192.168.199.23",
+							"protocol_port":       8080,
+							"provisioning_status": "ACTIVE",
+							"operating_status":    "ERROR",
+						},
+					},
+					"health_monitor": []map[string]interface{}{
+						{
+							"id":                  "monitor_1",
+							"name":                "monitor_1",
+							"type":                "HTTP",
+							"provisioning_status": "ACTIVE",
+							"operating_status":    "ONLINE",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, expected, flattenLBV2ListenersStatusTree(actual.Listeners))
+}
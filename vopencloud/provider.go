@@ -18,6 +18,16 @@ import (
 // Config struct.
 type Config struct {
 	auth.Config
+
+	// DefaultTags are merged into the tags of every taggable resource.
+	DefaultTags []string
+}
+
+// KubernetesV1Client returns a client for the managed Kubernetes service.
+// The endpoint is looked up in the catalog under the "kubernetes" type and
+// can be overridden with the "kubernetes" key of endpoint_overrides.
+func (c *Config) KubernetesV1Client(region string) (*gophercloud.ServiceClient, error) {
+	return c.CommonServiceClientInit(newKubernetesV1, region, kubernetesV1ServiceType)
 }
 
 // Provider returns a schema.Provider for OpenStack.
@@ -262,10 +272,27 @@ func Provider() *schema.Provider {
 				Default:     false,
 				Description: descriptions["enable_logging"],
 			},
+
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions["default_tags"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"vopencloud_blockstorage_availability_zones_v3":       dataSourceBlockStorageAvailabilityZonesV3(),
+			"vopencloud_blockstorage_backup_v3":                   dataSourceBlockStorageBackupV3(),
 			"vopencloud_blockstorage_snapshot_v2":                 dataSourceBlockStorageSnapshotV2(),
 			"vopencloud_blockstorage_snapshot_v3":                 dataSourceBlockStorageSnapshotV3(),
 			"vopencloud_blockstorage_volume_v2":                   dataSourceBlockStorageVolumeV2(),
@@ -274,14 +301,19 @@ func Provider() *schema.Provider {
 			"vopencloud_compute_aggregate_v2":                     dataSourceComputeAggregateV2(),
 			"vopencloud_compute_availability_zones_v2":            dataSourceComputeAvailabilityZonesV2(),
 			"vopencloud_compute_instance_v2":                      dataSourceComputeInstanceV2(),
+			"vopencloud_compute_instance_console_v2":              dataSourceComputeInstanceConsoleV2(),
+			"vopencloud_compute_instance_console_log_v2":          dataSourceComputeInstanceConsoleLogV2(),
 			"vopencloud_compute_flavor_v2":                        dataSourceComputeFlavorV2(),
+			"vopencloud_compute_flavors_v2":                       dataSourceComputeFlavorsV2(),
 			"vopencloud_compute_hypervisor_v2":                    dataSourceComputeHypervisorV2(),
 			"vopencloud_compute_keypair_v2":                       dataSourceComputeKeypairV2(),
 			"vopencloud_compute_quotaset_v2":                      dataSourceComputeQuotasetV2(),
+			"vopencloud_compute_servergroup_v2":                   dataSourceComputeServerGroupV2(),
 			"vopencloud_compute_limits_v2":                        dataSourceComputeLimitsV2(),
 			"vopencloud_containerinfra_nodegroup_v1":              dataSourceContainerInfraNodeGroupV1(),
 			"vopencloud_containerinfra_clustertemplate_v1":        dataSourceContainerInfraClusterTemplateV1(),
 			"vopencloud_containerinfra_cluster_v1":                dataSourceContainerInfraCluster(),
+			"vopencloud_db_backup_v1":                             dataSourceDatabaseBackupV1(),
 			"vopencloud_dns_zone_v2":                              dataSourceDNSZoneV2(),
 			"vopencloud_fw_group_v2":                              dataSourceFWGroupV2(),
 			"vopencloud_fw_policy_v1":                             dataSourceFWPolicyV1(),
@@ -319,13 +351,28 @@ func Provider() *schema.Provider {
 			"vopencloud_keymanager_secret_v1":                     dataSourceKeyManagerSecretV1(),
 			"vopencloud_keymanager_container_v1":                  dataSourceKeyManagerContainerV1(),
 			"vopencloud_kubernetes_v1":                            dataSourceKubernetesV1(),
+			"vopencloud_lb_loadbalancer_v2":                       dataSourceLBLoadBalancerV2(),
+			"vopencloud_lb_listener_v2":                           dataSourceLBListenerV2(),
+			"vopencloud_lb_pool_v2":                               dataSourceLBPoolV2(),
+			"vopencloud_lb_member_v2":                             dataSourceLBMemberV2(),
+			"vopencloud_lb_monitor_v2":                            dataSourceLBMonitorV2(),
+			"vopencloud_lb_l7policy_v2":                           dataSourceLBL7PolicyV2(),
+			"vopencloud_lb_l7rule_v2":                             dataSourceLBL7RuleV2(),
+			"vopencloud_lb_flavor_v2":                             dataSourceLBFlavorV2(),
+			"vopencloud_lb_flavorprofile_v2":                      dataSourceLBFlavorProfileV2(),
+			"vopencloud_lb_availability_zone_v2":                  dataSourceLBAvailabilityZoneV2(),
+			"vopencloud_lb_availability_zone_profile_v2":          dataSourceLBAvailabilityZoneProfileV2(),
+			"vopencloud_lb_amphorae_v2":                           dataSourceLBAmphoraeV2(),
+			"vopencloud_lb_loadbalancer_status_v2":                dataSourceLBLoadBalancerStatusV2(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
+			"vopencloud_blockstorage_backup_v3":                   resourceBlockStorageBackupV3(),
 			"vopencloud_blockstorage_qos_association_v3":          resourceBlockStorageQosAssociationV3(),
 			"vopencloud_blockstorage_qos_v3":                      resourceBlockStorageQosV3(),
 			"vopencloud_blockstorage_quotaset_v2":                 resourceBlockStorageQuotasetV2(),
 			"vopencloud_blockstorage_quotaset_v3":                 resourceBlockStorageQuotasetV3(),
+			"vopencloud_blockstorage_snapshot_v3":                 resourceBlockStorageSnapshotV3(),
 			"vopencloud_blockstorage_volume_v1":                   resourceBlockStorageVolumeV1(),
 			"vopencloud_blockstorage_volume_v2":                   resourceBlockStorageVolumeV2(),
 			"vopencloud_blockstorage_volume_v3":                   resourceBlockStorageVolumeV3(),
@@ -337,6 +384,7 @@ func Provider() *schema.Provider {
 			"vopencloud_compute_flavor_v2":                        resourceComputeFlavorV2(),
 			"vopencloud_compute_flavor_access_v2":                 resourceComputeFlavorAccessV2(),
 			"vopencloud_compute_instance_v2":                      resourceComputeInstanceV2(),
+			"vopencloud_compute_instance_snapshot_v2":             resourceComputeInstanceSnapshotV2(),
 			"vopencloud_compute_interface_attach_v2":              resourceComputeInterfaceAttachV2(),
 			"vopencloud_compute_keypair_v2":                       resourceComputeKeypairV2(),
 			"vopencloud_compute_secgroup_v2":                      resourceComputeSecGroupV2(),
@@ -352,14 +400,18 @@ func Provider() *schema.Provider {
 			"vopencloud_db_user_v1":                               resourceDatabaseUserV1(),
 			"vopencloud_db_configuration_v1":                      resourceDatabaseConfigurationV1(),
 			"vopencloud_db_database_v1":                           resourceDatabaseDatabaseV1(),
+			"vopencloud_db_backup_v1":                             resourceDatabaseBackupV1(),
+			"vopencloud_db_cluster_v1":                            resourceDatabaseClusterV1(),
 			"vopencloud_dns_recordset_v2":                         resourceDNSRecordSetV2(),
 			"vopencloud_dns_zone_v2":                              resourceDNSZoneV2(),
 			"vopencloud_dns_transfer_request_v2":                  resourceDNSTransferRequestV2(),
 			"vopencloud_dns_transfer_accept_v2":                   resourceDNSTransferAcceptV2(),
 			"vopencloud_fw_firewall_v1":                           resourceFWFirewallV1(),
 			"vopencloud_fw_group_v2":                              resourceFWGroupV2(),
+			"vopencloud_fw_group_port_association_v2":             resourceFWGroupPortAssociationV2(),
 			"vopencloud_fw_policy_v1":                             resourceFWPolicyV1(),
 			"vopencloud_fw_policy_v2":                             resourceFWPolicyV2(),
+			"vopencloud_fw_policy_rule_association_v2":            resourceFWPolicyRuleAssociationV2(),
 			"vopencloud_fw_rule_v1":                               resourceFWRuleV1(),
 			"vopencloud_fw_rule_v2":                               resourceFWRuleV2(),
 			"vopencloud_identity_endpoint_v3":                     resourceIdentityEndpointV3(),
@@ -389,6 +441,10 @@ func Provider() *schema.Provider {
 			"vopencloud_lb_l7policy_v2":                           resourceL7PolicyV2(),
 			"vopencloud_lb_l7rule_v2":                             resourceL7RuleV2(),
 			"vopencloud_lb_quota_v2":                              resourceLoadBalancerQuotaV2(),
+			"vopencloud_lb_flavor_v2":                             resourceLBFlavorV2(),
+			"vopencloud_lb_flavorprofile_v2":                      resourceLBFlavorProfileV2(),
+			"vopencloud_lb_availability_zone_v2":                  resourceLBAvailabilityZoneV2(),
+			"vopencloud_lb_availability_zone_profile_v2":          resourceLBAvailabilityZoneProfileV2(),
 			"vopencloud_networking_floatingip_v2":                 resourceNetworkingFloatingIPV2(),
 			"vopencloud_networking_floatingip_associate_v2":       resourceNetworkingFloatingIPAssociateV2(),
 			"vopencloud_networking_network_v2":                    resourceNetworkingNetworkV2(),
@@ -520,6 +576,8 @@ func init() {
 		"max_retries": "How many times HTTP connection should be retried until giving up.",
 
 		"enable_logging": "Outputs very verbose logs with all calls made to and responses from OpenStack",
+
+		"default_tags": "Tags which are added to every taggable resource managed by this provider.",
 	}
 }
 
@@ -539,7 +597,7 @@ func configureProvider(d *schema.ResourceData, terraformVersion string) (interfa
 	}
 
 	config := Config{
-		auth.Config{
+		Config: auth.Config{
 			CACertFile:                  d.Get("cacert_file").(string),
 			ClientCertFile:              d.Get("cert").(string),
 			ClientKeyFile:               d.Get("key").(string),
@@ -578,6 +636,12 @@ func configureProvider(d *schema.ResourceData, terraformVersion string) (interfa
 		},
 	}
 
+	if v, ok := d.GetOk("default_tags"); ok {
+		if rawDefaultTags, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			config.DefaultTags = expandToStringSlice(rawDefaultTags["tags"].(*schema.Set).List())
+		}
+	}
+
 	v, ok := d.GetOkExists("insecure")
 	if ok {
 		insecure := v.(bool)
@@ -20,6 +20,8 @@ var (
 	osDBEnvironment              = os.Getenv("OS_DB_ENVIRONMENT")
 	osDBDatastoreVersion         = os.Getenv("OS_DB_DATASTORE_VERSION")
 	osDBDatastoreType            = os.Getenv("OS_DB_DATASTORE_TYPE")
+	osDBClusterDatastoreVersion  = os.Getenv("OS_DB_CLUSTER_DATASTORE_VERSION")
+	osDBClusterDatastoreType     = os.Getenv("OS_DB_CLUSTER_DATASTORE_TYPE")
 	osDeprecatedEnvironment      = os.Getenv("OS_DEPRECATED_ENVIRONMENT")
 	osDNSEnvironment             = os.Getenv("OS_DNS_ENVIRONMENT")
 	osExtGwID                    = os.Getenv("OS_EXTGW_ID")
@@ -45,10 +47,13 @@ var (
 	osHypervisorEnvironment      = os.Getenv("OS_HYPERVISOR_HOSTNAME")
 	osPortForwardingEnvironment  = os.Getenv("OS_PORT_FORWARDING_ENVIRONMENT")
 	osBlockStorageV2             = os.Getenv("OS_BLOCKSTORAGE_V2")
+	osBlockStorageBackup         = os.Getenv("OS_BLOCKSTORAGE_BACKUP_ENVIRONMENT")
 	osMagnumHTTPProxy            = os.Getenv("OS_MAGNUM_HTTP_PROXY")
 	osMagnumHTTPSProxy           = os.Getenv("OS_MAGNUM_HTTPS_PROXY")
 	osMagnumNoProxy              = os.Getenv("OS_MAGNUM_NO_PROXY")
 	osMagnumLabels               = os.Getenv("OS_MAGNUM_LABELS")
+	osKubernetesEnvironment      = os.Getenv("OS_KUBERNETES_ENVIRONMENT")
+	osKubernetesVersion          = os.Getenv("OS_KUBERNETES_VERSION")
 )
 
 var (
@@ -133,6 +138,14 @@ func testAccPreCheckDatabase(t *testing.T) {
 	}
 }
 
+func testAccPreCheckDatabaseCluster(t *testing.T) {
+	testAccPreCheckDatabase(t)
+
+	if osDBClusterDatastoreType == "" || osDBClusterDatastoreVersion == "" {
+		t.Skip("OS_DB_CLUSTER_DATASTORE_TYPE and OS_DB_CLUSTER_DATASTORE_VERSION must be set for Database cluster tests")
+	}
+}
+
 func testAccPreCheckLB(t *testing.T) {
 	testAccPreCheckRequiredEnvVars(t)
 
@@ -149,6 +162,14 @@ func testAccPreCheckBlockStorageV2(t *testing.T) {
 	}
 }
 
+func testAccPreCheckBlockStorageBackup(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if osBlockStorageBackup == "" {
+		t.Skip("This environment does not support BlockStorage backup tests")
+	}
+}
+
 func testAccPreCheckUseOctavia(t *testing.T) {
 	testAccPreCheckRequiredEnvVars(t)
 
@@ -197,6 +218,18 @@ func testAccPreCheckContainerInfra(t *testing.T) {
 	}
 }
 
+func testAccPreCheckKubernetes(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if osKubernetesEnvironment == "" {
+		t.Skip("This environment does not support Kubernetes tests")
+	}
+
+	if osKubernetesVersion == "" {
+		t.Fatal("OS_KUBERNETES_VERSION must be set for acceptance tests")
+	}
+}
+
 func testAccPreCheckSFS(t *testing.T) {
 	testAccPreCheckRequiredEnvVars(t)
 
@@ -495,7 +528,7 @@ func testAccAuthFromEnv() (*Config, error) {
 	}
 
 	config := Config{
-		auth.Config{
+		Config: auth.Config{
 			CACertFile:                  os.Getenv("OS_CACERT"),
 			ClientCertFile:              os.Getenv("OS_CERT"),
 			ClientKeyFile:               os.Getenv("OS_KEY"),
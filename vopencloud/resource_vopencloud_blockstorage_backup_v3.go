@@ -0,0 +1,257 @@
+package vopencloud
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/backups"
+)
+
+func resourceBlockStorageBackupV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBlockStorageBackupV3Create,
+		ReadContext:   resourceBlockStorageBackupV3Read,
+		DeleteContext: resourceBlockStorageBackupV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"volume_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"container": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"incremental": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"object_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"has_dependent_backups": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageBackupV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	metadata := d.Get("metadata").(map[string]interface{})
+	createOpts := backups.CreateOpts{
+		VolumeID:         d.Get("volume_id").(string),
+		Force:            d.Get("force").(bool),
+		Name:             d.Get("name").(string),
+		Description:      d.Get("description").(string),
+		Metadata:         expandToMapStringString(metadata),
+		Container:        d.Get("container").(string),
+		Incremental:      d.Get("incremental").(bool),
+		SnapshotID:       d.Get("snapshot_id").(string),
+		AvailabilityZone: d.Get("availability_zone").(string),
+	}
+
+	blockStorageClient.Microversion = blockStorageBackupV3CreateMicroversion(createOpts)
+
+	log.Printf("[DEBUG] openstack_blockstorage_backup_v3 create options: %#v", createOpts)
+
+	b, err := backups.Create(blockStorageClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_blockstorage_backup_v3: %s", err)
+	}
+
+	d.SetId(b.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"available"},
+		Refresh:    blockStorageBackupV3StateRefreshFunc(blockStorageClient, b.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf(
+			"Error waiting for openstack_blockstorage_backup_v3 %s to become ready: %s", b.ID, err)
+	}
+
+	return resourceBlockStorageBackupV3Read(ctx, d, meta)
+}
+
+func resourceBlockStorageBackupV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	// Request the microversion, which returns metadata and availability_zone,
+	// also when they aren't known yet, e.g. after an import.
+	microversion, err := blockStorageV3MaxMicroversion(blockStorageClient)
+	if err == nil {
+		microversion, err = blockStorageBackupV3GetMicroversion(microversion)
+	}
+	if err != nil {
+		log.Printf("[DEBUG] Unable to determine Block Storage API microversion for openstack_blockstorage_backup_v3 %s: %s", d.Id(), err)
+		microversion = blockStorageBackupV3CreateMicroversion(backups.CreateOpts{
+			Metadata:         expandToMapStringString(d.Get("metadata").(map[string]interface{})),
+			AvailabilityZone: d.Get("availability_zone").(string),
+		})
+	}
+	blockStorageClient.Microversion = microversion
+
+	b, err := backups.Get(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_blockstorage_backup_v3"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_backup_v3 %s: %#v", d.Id(), b)
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("volume_id", b.VolumeID)
+	d.Set("name", b.Name)
+	d.Set("description", b.Description)
+	d.Set("container", b.Container)
+	d.Set("incremental", b.IsIncremental)
+	d.Set("snapshot_id", b.SnapshotID)
+	d.Set("size", b.Size)
+	d.Set("status", b.Status)
+	d.Set("object_count", b.ObjectCount)
+	d.Set("has_dependent_backups", b.HasDependentBackups)
+	d.Set("created_at", b.CreatedAt.Format(time.RFC3339))
+	d.Set("updated_at", b.UpdatedAt.Format(time.RFC3339))
+
+	if b.Metadata != nil {
+		d.Set("metadata", *b.Metadata)
+	}
+
+	if b.AvailabilityZone != nil {
+		d.Set("availability_zone", *b.AvailabilityZone)
+	}
+
+	return nil
+}
+
+func resourceBlockStorageBackupV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	if err := backups.Delete(blockStorageClient, d.Id()).ExtractErr(); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_blockstorage_backup_v3"))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available", "deleting"},
+		Target:     []string{"deleted"},
+		Refresh:    blockStorageBackupV3StateRefreshFunc(blockStorageClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf(
+			"Error waiting for openstack_blockstorage_backup_v3 %s to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/backups"
+)
+
+func TestAccBlockStorageV3Backup_basic(t *testing.T) {
+	var backup backups.Backup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckBlockStorageBackup(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckBlockStorageV3BackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3BackupBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3BackupExists("openstack_blockstorage_backup_v3.backup_1", &backup),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_1", "name", "backup_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_1", "size", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_1", "status", "available"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_2", "incremental", "true"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_volume_v3.volume_2", "backup_id",
+						"openstack_blockstorage_backup_v3.backup_2", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3BackupDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_blockstorage_backup_v3" {
+			continue
+		}
+
+		_, err := backups.Get(blockStorageClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Backup still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBlockStorageV3BackupExists(n string, backup *backups.Backup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		blockStorageClient, err := config.BlockStorageV3Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		found, err := backups.Get(blockStorageClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Backup not found")
+		}
+
+		*backup = *found
+
+		return nil
+	}
+}
+
+const testAccBlockStorageV3BackupBasic = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_backup_v3" "backup_1" {
+  name      = "backup_1"
+  volume_id = openstack_blockstorage_volume_v3.volume_1.id
+}
+
+resource "openstack_blockstorage_backup_v3" "backup_2" {
+  name        = "backup_2"
+  volume_id   = openstack_blockstorage_volume_v3.volume_1.id
+  incremental = true
+
+  depends_on = [openstack_blockstorage_backup_v3.backup_1]
+}
+
+resource "openstack_blockstorage_volume_v3" "volume_2" {
+  name      = "volume_2"
+  size      = 1
+  backup_id = openstack_blockstorage_backup_v3.backup_2.id
+}
+`
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/schedulerhints"
@@ -28,6 +29,7 @@ func resourceBlockStorageVolumeV3() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -93,13 +95,27 @@ func resourceBlockStorageVolumeV3() *schema.Resource {
 				ForceNew: true,
 			},
 
-			"volume_type": {
+			"backup_id": {
 				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: true,
+			},
+
+			"volume_type": {
+				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
 			},
 
+			"migration_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(volumeactions.MigrationPolicyNever),
+					string(volumeactions.MigrationPolicyOnDemand),
+				}, false),
+			},
+
 			"consistency_group_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -190,6 +206,7 @@ func resourceBlockStorageVolumeV3Create(ctx context.Context, d *schema.ResourceD
 	metadata := d.Get("metadata").(map[string]interface{})
 	volumeCreateOpts := &volumes.CreateOpts{
 		AvailabilityZone:   d.Get("availability_zone").(string),
+		BackupID:           d.Get("backup_id").(string),
 		ConsistencyGroupID: d.Get("consistency_group_id").(string),
 		Description:        d.Get("description").(string),
 		ImageID:            d.Get("image_id").(string),
@@ -216,6 +233,11 @@ func resourceBlockStorageVolumeV3Create(ctx context.Context, d *schema.ResourceD
 		SchedulerHints:          schedulerHints,
 	}
 
+	// Creating a volume from a backup requires microversion 3.47.
+	if volumeCreateOpts.BackupID != "" {
+		blockStorageClient.Microversion = "3.47"
+	}
+
 	log.Printf("[DEBUG] openstack_blockstorage_volume_v3 create options: %#v", createOpts)
 
 	v, err := volumes.Create(blockStorageClient, createOpts).Extract()
@@ -263,6 +285,9 @@ func resourceBlockStorageVolumeV3Read(_ context.Context, d *schema.ResourceData,
 	d.Set("name", v.Name)
 	d.Set("snapshot_id", v.SnapshotID)
 	d.Set("source_vol_id", v.SourceVolID)
+	if v.BackupID != nil {
+		d.Set("backup_id", *v.BackupID)
+	}
 	d.Set("volume_type", v.VolumeType)
 	d.Set("metadata", v.Metadata)
 	d.Set("region", GetRegion(d, config))
@@ -312,7 +337,22 @@ func resourceBlockStorageVolumeV3Update(ctx context.Context, d *schema.ResourceD
 					see enable_online_resize option`, d.Id())
 			}
 
-			blockStorageClient.Microversion = "3.42"
+			maxMicroversion, err := blockStorageV3MaxMicroversion(blockStorageClient)
+			if err != nil {
+				return diag.Errorf("Error retrieving Block Storage API microversion for openstack_blockstorage_volume_v3 %s: %s", d.Id(), err)
+			}
+
+			ok, err := compatibleMicroversion("min", blockStorageV3OnlineResizeMicroversion, maxMicroversion)
+			if err != nil {
+				return diag.Errorf("Error comparing microversions for openstack_blockstorage_volume_v3 %s: %s", d.Id(), err)
+			}
+			if !ok {
+				return diag.Errorf("Error extending openstack_blockstorage_volume_v3 %s: "+
+					"the Block Storage API microversion %s doesn't support extending attached volumes, "+
+					"microversion %s or later is required", d.Id(), maxMicroversion, blockStorageV3OnlineResizeMicroversion)
+			}
+
+			blockStorageClient.Microversion = blockStorageV3OnlineResizeMicroversion
 		}
 
 		extendOpts := volumeactions.ExtendSizeOpts{
@@ -328,7 +368,7 @@ func resourceBlockStorageVolumeV3Update(ctx context.Context, d *schema.ResourceD
 			Pending:    []string{"extending"},
 			Target:     []string{"available", "in-use"},
 			Refresh:    blockStorageVolumeV3StateRefreshFunc(blockStorageClient, d.Id()),
-			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
 			Delay:      10 * time.Second,
 			MinTimeout: 3 * time.Second,
 		}
@@ -340,6 +380,47 @@ func resourceBlockStorageVolumeV3Update(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
+	if d.HasChange("volume_type") {
+		changeTypeOpts := volumeactions.ChangeTypeOpts{
+			NewType:         d.Get("volume_type").(string),
+			MigrationPolicy: volumeactions.MigrationPolicy(d.Get("migration_policy").(string)),
+		}
+
+		log.Printf("[DEBUG] openstack_blockstorage_volume_v3 %s retype options: %#v", d.Id(), changeTypeOpts)
+
+		err = volumeactions.ChangeType(blockStorageClient, d.Id(), changeTypeOpts).ExtractErr()
+		if err != nil {
+			return diag.Errorf("Error changing openstack_blockstorage_volume_v3 %s volume type: %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"retyping"},
+			Target:     []string{"available", "in-use"},
+			Refresh:    blockStorageVolumeV3StateRefreshFunc(blockStorageClient, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err := stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.Errorf(
+				"Error waiting for openstack_blockstorage_volume_v3 %s to be retyped: %s", d.Id(), err)
+		}
+
+		// A failed retype returns the volume to its previous status, so
+		// make sure the new type has been applied.
+		v, err = volumes.Get(blockStorageClient, d.Id()).Extract()
+		if err != nil {
+			return diag.Errorf("Error retrieving openstack_blockstorage_volume_v3 %s: %s", d.Id(), err)
+		}
+
+		if v.VolumeType != changeTypeOpts.NewType {
+			return diag.Errorf("Error changing openstack_blockstorage_volume_v3 %s volume type: "+
+				"the volume type is still %s, check the Block Storage API logs", d.Id(), v.VolumeType)
+		}
+	}
+
 	_, err = volumes.Update(blockStorageClient, d.Id(), updateOpts).Extract()
 	if err != nil {
 		return diag.Errorf("Error updating openstack_blockstorage_volume_v3 %s: %s", d.Id(), err)
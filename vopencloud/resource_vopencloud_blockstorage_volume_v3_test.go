@@ -146,6 +146,37 @@ func TestAccBlockStorageV3Volume_timeout(t *testing.T) {
 	})
 }
 
+func TestAccBlockStorageV3Volume_retype(t *testing.T) {
+	var volume volumes.Volume
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckBlockStorageV3VolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3VolumeRetype("volume_type_1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeExists("openstack_blockstorage_volume_v3.volume_1", &volume),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v3.volume_1", "volume_type", "volume_type_1"),
+				),
+			},
+			{
+				Config: testAccBlockStorageV3VolumeRetype("volume_type_2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeSameID("openstack_blockstorage_volume_v3.volume_1", &volume),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v3.volume_1", "volume_type", "volume_type_2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckBlockStorageV3VolumeDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	blockStorageClient, err := config.BlockStorageV3Client(osRegionName)
@@ -309,6 +340,25 @@ resource "openstack_blockstorage_volume_v3" "volume_1" {
 `, osImageID)
 }
 
+func testAccBlockStorageV3VolumeRetype(volumeType string) string {
+	return fmt.Sprintf(`
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "volume_type_1"
+}
+
+resource "openstack_blockstorage_volume_type_v3" "volume_type_2" {
+  name = "volume_type_2"
+}
+
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name             = "volume_1"
+  size             = 1
+  volume_type      = openstack_blockstorage_volume_type_v3.%s.name
+  migration_policy = "on-demand"
+}
+`, volumeType)
+}
+
 const testAccBlockStorageV3VolumeTimeout = `
 resource "openstack_blockstorage_volume_v3" "volume_1" {
   name = "volume_1"
@@ -321,3 +371,18 @@ resource "openstack_blockstorage_volume_v3" "volume_1" {
   }
 }
 `
+
+func testAccCheckBlockStorageV3VolumeSameID(n string, volume *volumes.Volume) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID != volume.ID {
+			return fmt.Errorf("Volume was recreated: %s != %s", rs.Primary.ID, volume.ID)
+		}
+
+		return nil
+	}
+}
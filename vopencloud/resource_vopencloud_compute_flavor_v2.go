@@ -3,8 +3,10 @@ package vopencloud
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
@@ -20,6 +22,12 @@ func resourceComputeFlavorV2() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -130,7 +138,14 @@ func resourceComputeFlavorV2Create(ctx context.Context, d *schema.ResourceData,
 	}
 
 	log.Printf("[DEBUG] openstack_compute_flavor_v2 create options: %#v", createOpts)
-	fl, err := flavors.Create(computeClient, &createOpts).Extract()
+	var fl *flavors.Flavor
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		fl, err = flavors.Create(computeClient, &createOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
 	if err != nil {
 		return diag.Errorf("Error creating openstack_compute_flavor_v2 %s: %s", name, err)
 	}
@@ -139,9 +154,7 @@ func resourceComputeFlavorV2Create(ctx context.Context, d *schema.ResourceData,
 
 	extraSpecsRaw := d.Get("extra_specs").(map[string]interface{})
 	if len(extraSpecsRaw) > 0 {
-		extraSpecs := expandComputeFlavorV2ExtraSpecs(extraSpecsRaw)
-
-		_, err := flavors.CreateExtraSpecs(computeClient, fl.ID, extraSpecs).Extract()
+		err = computeFlavorV2UpdateExtraSpecs(ctx, computeClient, fl.ID, nil, extraSpecsRaw, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return diag.Errorf("Error creating extra_specs for openstack_compute_flavor_v2 %s: %s", fl.ID, err)
 		}
@@ -204,57 +217,54 @@ func resourceComputeFlavorV2Update(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("Error creating OpenStack compute client: %s", err)
 	}
 
-	var hasChange bool
-	var updateOpts flavors.UpdateOpts
-
 	if d.HasChange("description") {
-		hasChange = true
-		description := d.Get("description").(string)
-		updateOpts.Description = description
+		var updateOpts computeFlavorV2UpdateOpts
+		if description := d.Get("description").(string); description != "" {
+			updateOpts.Description = &description
+		}
+
+		// description requires nova microversion 2.55.
 		computeClient.Microversion = computeV2FlavorDescriptionMicroversion
-	}
 
-	if hasChange {
 		log.Printf("[DEBUG] openstack_compute_flavor_v2 %s update options: %#v", d.Id(), updateOpts)
-		_, err = flavors.Update(computeClient, d.Id(), updateOpts).Extract()
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			_, err = flavors.Update(computeClient, d.Id(), updateOpts).Extract()
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+			return nil
+		})
 		if err != nil {
-			return diag.Errorf("Error openstack_compute_flavor_v2 %s: %s", d.Id(), err)
+			return diag.Errorf("Error updating openstack_compute_flavor_v2 %s: %s", d.Id(), err)
 		}
 	}
 
 	if d.HasChange("extra_specs") {
 		oldES, newES := d.GetChange("extra_specs")
-
-		// Delete all old extra specs.
-		for oldKey := range oldES.(map[string]interface{}) {
-			if err := flavors.DeleteExtraSpec(computeClient, d.Id(), oldKey).ExtractErr(); err != nil {
-				return diag.Errorf("Error deleting extra_spec %s from openstack_compute_flavor_v2 %s: %s", oldKey, d.Id(), err)
-			}
-		}
-
-		// Add new extra specs.
-		newESRaw := newES.(map[string]interface{})
-		if len(newESRaw) > 0 {
-			extraSpecs := expandComputeFlavorV2ExtraSpecs(newESRaw)
-
-			_, err := flavors.CreateExtraSpecs(computeClient, d.Id(), extraSpecs).Extract()
-			if err != nil {
-				return diag.Errorf("Error creating extra_specs for openstack_compute_flavor_v2 %s: %s", d.Id(), err)
-			}
+		err = computeFlavorV2UpdateExtraSpecs(ctx, computeClient, d.Id(),
+			oldES.(map[string]interface{}), newES.(map[string]interface{}), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("Error updating extra_specs for openstack_compute_flavor_v2 %s: %s", d.Id(), err)
 		}
 	}
 
 	return resourceComputeFlavorV2Read(ctx, d, meta)
 }
 
-func resourceComputeFlavorV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceComputeFlavorV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	computeClient, err := config.ComputeV2Client(GetRegion(d, config))
 	if err != nil {
 		return diag.Errorf("Error creating OpenStack compute client: %s", err)
 	}
 
-	err = flavors.Delete(computeClient, d.Id()).ExtractErr()
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		err = flavors.Delete(computeClient, d.Id()).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
 	if err != nil {
 		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_compute_flavor_v2"))
 	}
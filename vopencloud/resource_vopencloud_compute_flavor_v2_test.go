@@ -84,7 +84,7 @@ func TestAccComputeV2Flavor_extraSpecs(t *testing.T) {
 			{
 				Config: testAccComputeV2FlavorExtraSpecs2(flavorName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckComputeV2FlavorExists("openstack_compute_flavor_v2.flavor_1", &flavor),
+					testAccCheckComputeV2FlavorSameID("openstack_compute_flavor_v2.flavor_1", &flavor),
 					resource.TestCheckResourceAttr(
 						"openstack_compute_flavor_v2.flavor_1", "description", "bar"),
 					resource.TestCheckResourceAttr(
@@ -93,6 +93,20 @@ func TestAccComputeV2Flavor_extraSpecs(t *testing.T) {
 						"openstack_compute_flavor_v2.flavor_1", "extra_specs.hw:cpu_policy", "CPU-POLICY-2"),
 				),
 			},
+			{
+				Config: testAccComputeV2FlavorExtraSpecs3(flavorName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2FlavorSameID("openstack_compute_flavor_v2.flavor_1", &flavor),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_flavor_v2.flavor_1", "description", ""),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_flavor_v2.flavor_1", "extra_specs.%", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_flavor_v2.flavor_1", "extra_specs.hw:cpu_policy", "CPU-POLICY-2"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_flavor_v2.flavor_1", "extra_specs.hw:mem_page_size", "large"),
+				),
+			},
 		},
 	})
 }
@@ -150,6 +164,21 @@ func testAccCheckComputeV2FlavorExists(n string, flavor *flavors.Flavor) resourc
 	}
 }
 
+func testAccCheckComputeV2FlavorSameID(n string, flavor *flavors.Flavor) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID != flavor.ID {
+			return fmt.Errorf("Flavor was recreated: %s != %s", rs.Primary.ID, flavor.ID)
+		}
+
+		return nil
+	}
+}
+
 func testAccComputeV2FlavorBasic(flavorName string) string {
 	return fmt.Sprintf(`
     resource "openstack_compute_flavor_v2" "flavor_1" {
@@ -213,3 +242,21 @@ func testAccComputeV2FlavorExtraSpecs2(flavorName string) string {
     }
     `, flavorName)
 }
+
+func testAccComputeV2FlavorExtraSpecs3(flavorName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_compute_flavor_v2" "flavor_1" {
+      name = "%s"
+      ram = 2048
+      vcpus = 2
+      disk = 5
+
+      is_public = true
+
+      extra_specs = {
+        "hw:cpu_policy" = "CPU-POLICY-2",
+        "hw:mem_page_size" = "large"
+      }
+    }
+    `, flavorName)
+}
@@ -0,0 +1,192 @@
+package vopencloud
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+)
+
+func resourceComputeInstanceSnapshotV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceComputeInstanceSnapshotV2Create,
+		ReadContext:   resourceComputeInstanceSnapshotV2Read,
+		UpdateContext: resourceComputeInstanceSnapshotV2Update,
+		DeleteContext: resourceComputeInstanceSnapshotV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"retain_on_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"image_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeInstanceSnapshotV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	computeClient, err := config.ComputeV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+	imageClient, err := config.ImageV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	createOpts := servers.CreateImageOpts{
+		Name:     d.Get("name").(string),
+		Metadata: resourceInstanceMetadataV2(d),
+	}
+
+	log.Printf("[DEBUG] openstack_compute_instance_snapshot_v2 create options: %#v", createOpts)
+
+	imageID, err := servers.CreateImage(computeClient, instanceID, createOpts).ExtractImageID()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_compute_instance_snapshot_v2 of %s instance: %s", instanceID, err)
+	}
+
+	d.SetId(imageID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{string(images.ImageStatusQueued), string(images.ImageStatusSaving), string(images.ImageStatusImporting)},
+		Target:     []string{string(images.ImageStatusActive)},
+		Refresh:    resourceImagesImageV2RefreshFunc(imageClient, imageID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for openstack_compute_instance_snapshot_v2 %s to become active", imageID)
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("Error waiting for openstack_compute_instance_snapshot_v2 %s to become active: %s", imageID, err)
+	}
+
+	return resourceComputeInstanceSnapshotV2Read(ctx, d, meta)
+}
+
+func resourceComputeInstanceSnapshotV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	imageClient, err := config.ImageV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	img, err := images.Get(imageClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_compute_instance_snapshot_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_compute_instance_snapshot_v2 %s: %#v", d.Id(), img)
+
+	// The compute service stores the source instance ID in the image properties.
+	if v, ok := img.Properties["instance_uuid"].(string); ok && v != "" {
+		d.Set("instance_id", v)
+	}
+
+	metadata := flattenComputeInstanceSnapshotV2Metadata(img.Properties, d.Get("metadata").(map[string]interface{}))
+	if err := d.Set("metadata", metadata); err != nil {
+		log.Printf("[DEBUG] Unable to set metadata for openstack_compute_instance_snapshot_v2 %s: %s", d.Id(), err)
+	}
+
+	d.Set("name", img.Name)
+	d.Set("image_id", img.ID)
+	d.Set("status", img.Status)
+	d.Set("size_bytes", img.SizeBytes)
+	d.Set("checksum", img.Checksum)
+	d.Set("created_at", img.CreatedAt.Format(time.RFC3339))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceComputeInstanceSnapshotV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only retain_on_delete can be updated, which is stored in the state.
+	return resourceComputeInstanceSnapshotV2Read(ctx, d, meta)
+}
+
+func resourceComputeInstanceSnapshotV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get("retain_on_delete").(bool) {
+		log.Printf("[DEBUG] Retaining openstack_compute_instance_snapshot_v2 %s image on delete", d.Id())
+		return nil
+	}
+
+	config := meta.(*Config)
+	imageClient, err := config.ImageV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	err = images.Delete(imageClient, d.Id()).ExtractErr()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_compute_instance_snapshot_v2"))
+	}
+
+	return nil
+}
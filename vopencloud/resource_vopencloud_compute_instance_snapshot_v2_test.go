@@ -0,0 +1,131 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+)
+
+func TestAccComputeV2InstanceSnapshot_basic(t *testing.T) {
+	var image images.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckComputeV2InstanceSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2InstanceSnapshotBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceSnapshotExists("openstack_compute_instance_snapshot_v2.snapshot_1", &image),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_snapshot_v2.snapshot_1", "name", "snapshot_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_snapshot_v2.snapshot_1", "status", "active"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_compute_instance_snapshot_v2.snapshot_1", "instance_id",
+						"openstack_compute_instance_v2.instance_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_compute_instance_snapshot_v2.snapshot_1", "image_id",
+						"openstack_compute_instance_snapshot_v2.snapshot_1", "id"),
+					testAccCheckComputeV2InstanceSnapshotProperty(&image, "pipeline", "golden"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeV2InstanceSnapshotDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	imageClient, err := config.ImageV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_compute_instance_snapshot_v2" {
+			continue
+		}
+
+		_, err := images.Get(imageClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Instance snapshot still exists")
+		}
+		if _, ok := err.(gophercloud.ErrDefault404); !ok {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckComputeV2InstanceSnapshotExists(n string, image *images.Image) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		imageClient, err := config.ImageV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack image client: %s", err)
+		}
+
+		found, err := images.Get(imageClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Instance snapshot not found")
+		}
+
+		*image = *found
+
+		return nil
+	}
+}
+
+func testAccCheckComputeV2InstanceSnapshotProperty(image *images.Image, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if v, ok := image.Properties[key]; !ok || v != value {
+			return fmt.Errorf("Expected %s property to be %s, got %v", key, value, v)
+		}
+
+		return nil
+	}
+}
+
+func testAccComputeV2InstanceSnapshotBasic() string {
+	return fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "openstack_compute_instance_snapshot_v2" "snapshot_1" {
+  instance_id = "${openstack_compute_instance_v2.instance_1.id}"
+  name        = "snapshot_1"
+
+  metadata = {
+    pipeline = "golden"
+  }
+}
+`, osNetworkID)
+}
@@ -22,6 +22,7 @@ import (
 	volumesV3 "github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/bootfromvolume"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/extendedserverattributes"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/keypairs"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/schedulerhints"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/secgroups"
@@ -406,10 +407,49 @@ func resourceComputeInstanceV2() *schema.Resource {
 				ForceNew: false,
 				Default:  "active",
 				ValidateFunc: validation.StringInSlice([]string{
-					"active", "shutoff", "shelved_offloaded",
+					"active", "shutoff", "shelved", "shelved_offloaded",
 				}, true),
 				DiffSuppressFunc: suppressPowerStateDiffs,
 			},
+			"host": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"migration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "live",
+							ValidateFunc: validation.StringInSlice([]string{
+								"live", "cold", "evacuate",
+							}, false),
+						},
+						"block_migration": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "auto",
+							ValidateFunc: validation.StringInSlice([]string{
+								"auto", "true", "false",
+							}, false),
+						},
+						"ignore_confirmation": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"trigger": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -420,6 +460,12 @@ func resourceComputeInstanceV2() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+
+			"default_tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"vendor_options": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -458,6 +504,7 @@ func resourceComputeInstanceV2() *schema.Resource {
 			customdiff.ForceNewIfChange("flavor_name", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old.(string) == ""
 			}),
+			customizeDiffObjectDefaultTags,
 		),
 	}
 }
@@ -521,7 +568,7 @@ func resourceComputeInstanceV2Create(ctx context.Context, d *schema.ResourceData
 	configDrive := d.Get("config_drive").(bool)
 
 	// Retrieve tags and set microversion if they're provided.
-	instanceTags := computeV2InstanceTags(d)
+	instanceTags := computeV2InstanceTags(d, config.DefaultTags)
 	if len(instanceTags) > 0 {
 		computeClient.Microversion = computeV2InstanceCreateServerWithTagsMicroversion
 	}
@@ -592,6 +639,14 @@ func resourceComputeInstanceV2Create(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	if host := d.Get("host").(string); host != "" {
+		computeClient.Microversion = computeV2InstanceCreateServerWithHostMicroversion
+		createOpts = &computeV2InstanceCreateOptsExt{
+			CreateOptsBuilder: createOpts,
+			Host:              host,
+		}
+	}
+
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 
 	// If a block_device is used, use the bootfromvolume.Create function as it allows an empty ImageRef.
@@ -768,6 +823,7 @@ func resourceComputeInstanceV2Read(_ context.Context, d *schema.ResourceData, me
 	var serverWithAZ struct {
 		servers.Server
 		availabilityzones.ServerAvailabilityZoneExt
+		extendedserverattributes.ServerAttributesExt
 	}
 
 	// Do another Get so the above work is not disturbed.
@@ -778,6 +834,9 @@ func resourceComputeInstanceV2Read(_ context.Context, d *schema.ResourceData, me
 	// Set the availability zone
 	d.Set("availability_zone", serverWithAZ.AvailabilityZone)
 
+	// Set the compute host, which is only visible to admin users
+	d.Set("host", serverWithAZ.Host)
+
 	// Set the region
 	d.Set("region", GetRegion(d, config))
 
@@ -821,15 +880,58 @@ func resourceComputeInstanceV2Update(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	// A changed migration trigger moves the instance without a target host,
+	// so that the scheduler chooses the host.
+	hostChanged := d.HasChange("host") && d.Get("host").(string) != ""
+	triggerChanged := d.HasChange("migration.0.trigger") && d.Get("migration.0.trigger").(string) != ""
+	if hostChanged || triggerChanged {
+		migration := expandComputeV2InstanceMigration(d)
+		err = computeV2InstanceMigrate(ctx, computeClient, d.Id(), migration, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			// Keep the previous host and trigger in the state, so that the
+			// migration is retried.
+			oldHost, _ := d.GetChange("host")
+			d.Set("host", oldHost)
+			oldMigration, _ := d.GetChange("migration")
+			d.Set("migration", oldMigration)
+			return diag.Errorf("Error migrating openstack_compute_instance_v2 %s: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("power_state") {
 		powerStateOldRaw, powerStateNewRaw := d.GetChange("power_state")
 		powerStateOld := powerStateOldRaw.(string)
 		powerStateNew := powerStateNewRaw.(string)
-		if strings.ToLower(powerStateNew) == "shelved_offloaded" {
+		if strings.ToLower(powerStateNew) == "shelved" {
 			err = shelveunshelve.Shelve(computeClient, d.Id()).ExtractErr()
 			if err != nil {
 				return diag.Errorf("Error shelve OpenStack instance: %s", err)
 			}
+			shelveStateConf := &resource.StateChangeConf{
+				// The instance is offloaded immediately, when the cloud
+				// shelved_offload_time is set to 0.
+				Target:     []string{"SHELVED", "SHELVED_OFFLOADED"},
+				Refresh:    ServerV2StateRefreshFunc(computeClient, d.Id()),
+				Timeout:    d.Timeout(schema.TimeoutUpdate),
+				Delay:      10 * time.Second,
+				MinTimeout: 3 * time.Second,
+			}
+
+			log.Printf("[DEBUG] Waiting for instance (%s) to shelve", d.Id())
+			_, err = shelveStateConf.WaitForStateContext(ctx)
+			if err != nil {
+				return diag.Errorf("Error waiting for instance (%s) to become shelve: %s", d.Id(), err)
+			}
+		}
+		if strings.ToLower(powerStateNew) == "shelved_offloaded" {
+			if strings.ToLower(powerStateOld) == "shelved" {
+				err = shelveunshelve.ShelveOffload(computeClient, d.Id()).ExtractErr()
+			} else {
+				err = shelveunshelve.Shelve(computeClient, d.Id()).ExtractErr()
+			}
+			if err != nil {
+				return diag.Errorf("Error shelve OpenStack instance: %s", err)
+			}
 			shelveStateConf := &resource.StateChangeConf{
 				//Pending:    []string{"ACTIVE"},
 				Target:     []string{"SHELVED_OFFLOADED"},
@@ -1109,8 +1211,8 @@ func resourceComputeInstanceV2Update(ctx context.Context, d *schema.ResourceData
 	}
 
 	// Perform any required updates to the tags.
-	if d.HasChange("tags") {
-		instanceTags := computeV2InstanceUpdateTags(d)
+	if d.HasChanges("tags", "all_tags") {
+		instanceTags := computeV2InstanceUpdateTags(d, config.DefaultTags)
 		instanceTagsOpts := tags.ReplaceAllOpts{Tags: instanceTags}
 		computeClient.Microversion = computeV2TagsExtensionMicroversion
 		instanceTags, err := tags.ReplaceAll(computeClient, d.Id(), instanceTagsOpts).Extract()
@@ -1669,11 +1771,15 @@ func suppressAvailabilityZoneDetailDiffs(_, old, new string, _ *schema.ResourceD
 }
 
 // suppressPowerStateDiffs will allow a state of "error" or "migrating" even though we don't
-// allow them as a user input.
-func suppressPowerStateDiffs(_, old, _ string, _ *schema.ResourceData) bool {
+// allow them as a user input. A "shelved" instance may also be offloaded by the cloud.
+func suppressPowerStateDiffs(_, old, new string, _ *schema.ResourceData) bool {
 	if old == "error" || old == "migrating" {
 		return true
 	}
 
+	if old == "shelved_offloaded" && strings.ToLower(new) == "shelved" {
+		return true
+	}
+
 	return false
 }
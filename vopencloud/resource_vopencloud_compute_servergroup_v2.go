@@ -102,9 +102,23 @@ func resourceComputeServerGroupV2Create(ctx context.Context, d *schema.ResourceD
 		MapValueSpecs(d),
 	}
 
-	rulesVal, rulesPresent := d.GetOk("rules")
-	if policy == "anti-affinity" && rulesPresent {
-		computeClient.Microversion = "2.64"
+	if rulesVal, ok := d.GetOk("rules"); ok {
+		if policy != antiAffinityPolicy {
+			return diag.Errorf("Error creating openstack_compute_servergroup_v2 %s: "+
+				"rules are only supported with the %s policy", name, antiAffinityPolicy)
+		}
+
+		maxMicroversion, ok, err := computeV2ServerGroupRulesSupported(computeClient)
+		if err != nil {
+			return diag.Errorf("Error retrieving Compute API microversion for openstack_compute_servergroup_v2 %s: %s", name, err)
+		}
+		if !ok {
+			return diag.Errorf("Error creating openstack_compute_servergroup_v2 %s: "+
+				"the Compute API microversion %s doesn't support rules, "+
+				"microversion %s or later is required", name, maxMicroversion, computeV2ServerGroupRulesMicroversion)
+		}
+
+		computeClient.Microversion = computeV2ServerGroupRulesMicroversion
 		createOpts.CreateOpts.Rules = &servergroups.Rules{
 			MaxServerPerHost: expandComputeServerGroupV2RulesMaxServerPerHost(rulesVal.([]interface{})),
 		}
@@ -146,18 +160,18 @@ func resourceComputeServerGroupV2Read(_ context.Context, d *schema.ResourceData,
 		return diag.Errorf("Error creating OpenStack compute client: %s", err)
 	}
 
-	// Attempt to read with microversion 2.64
-	computeClient.Microversion = "2.64"
-	sg, err := servergroups.Get(computeClient, d.Id()).Extract()
+	// Read the rules, when the Compute API supports them.
+	_, ok, err := computeV2ServerGroupRulesSupported(computeClient)
 	if err != nil {
-		log.Printf("[DEBUG] Falling back to legacy API call due to: %#v", err)
-		// fallback to legacy microversion
-		computeClient.Microversion = ""
+		log.Printf("[DEBUG] Unable to determine Compute API microversion for openstack_compute_servergroup_v2 %s: %s", d.Id(), err)
+	}
+	if ok {
+		computeClient.Microversion = computeV2ServerGroupRulesMicroversion
+	}
 
-		sg, err = servergroups.Get(computeClient, d.Id()).Extract()
-		if err != nil {
-			return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_compute_servergroup_v2"))
-		}
+	sg, err := servergroups.Get(computeClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_compute_servergroup_v2"))
 	}
 
 	log.Printf("[DEBUG] Retrieved openstack_compute_servergroup_v2 %s: %#v", d.Id(), sg)
@@ -165,16 +179,8 @@ func resourceComputeServerGroupV2Read(_ context.Context, d *schema.ResourceData,
 	d.Set("name", sg.Name)
 	d.Set("members", sg.Members)
 	d.Set("region", GetRegion(d, config))
-	if sg.Policy != nil && *sg.Policy != "" {
-		d.Set("policies", []string{*sg.Policy})
-	} else {
-		d.Set("policies", sg.Policies)
-	}
-	if sg.Rules != nil {
-		d.Set("rules", []map[string]interface{}{{"max_server_per_host": sg.Rules.MaxServerPerHost}})
-	} else {
-		d.Set("rules", nil)
-	}
+	d.Set("policies", flattenComputeServerGroupV2Policies(sg))
+	d.Set("rules", flattenComputeServerGroupV2Rules(sg.Rules))
 
 	return nil
 }
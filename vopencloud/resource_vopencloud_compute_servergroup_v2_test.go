@@ -125,6 +125,23 @@ func TestAccComputeV2ServerGroup_v2_64_with_invalid_rules(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2ServerGroup_v2_64_with_rules_affinity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckComputeV2ServerGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccComputeV2ServerGroupV264AffinityPolicyRules,
+				ExpectError: regexp.MustCompile(`rules are only supported with the anti-affinity policy`),
+			},
+		},
+	})
+}
+
 func TestAccComputeV2ServerGroup_affinity(t *testing.T) {
 	var instance servers.Server
 	var sg servergroups.ServerGroup
@@ -315,6 +332,16 @@ resource "openstack_compute_servergroup_v2" "sg_1" {
 }
 `
 
+const testAccComputeV2ServerGroupV264AffinityPolicyRules = `
+resource "openstack_compute_servergroup_v2" "sg_1" {
+  name = "sg_1"
+  policies = ["affinity"]
+  rules {
+    max_server_per_host = 2
+  }
+}
+`
+
 func testAccComputeV2ServerGroupAffinity() string {
 	return fmt.Sprintf(`
 resource "openstack_compute_servergroup_v2" "sg_1" {
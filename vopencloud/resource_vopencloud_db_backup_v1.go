@@ -0,0 +1,220 @@
+package vopencloud
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDatabaseBackupV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDatabaseBackupV1Create,
+		ReadContext:   resourceDatabaseBackupV1Read,
+		DeleteContext: resourceDatabaseBackupV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"parent_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"incremental": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"size": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"location_ref": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"datastore": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDatabaseBackupV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	DatabaseV1Client, err := config.DatabaseV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack database client: %s", err)
+	}
+
+	createOpts := databaseBackupV1CreateOpts{
+		Name:        d.Get("name").(string),
+		InstanceID:  d.Get("instance_id").(string),
+		Description: d.Get("description").(string),
+		ParentID:    d.Get("parent_id").(string),
+	}
+
+	if d.Get("incremental").(bool) || createOpts.ParentID != "" {
+		createOpts.Incremental = 1
+	}
+
+	log.Printf("[DEBUG] openstack_db_backup_v1 create options: %#v", createOpts)
+
+	backup, err := databaseBackupV1Create(DatabaseV1Client, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_db_backup_v1: %s", err)
+	}
+
+	d.SetId(backup.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"NEW", "BUILDING", "SAVING"},
+		Target:     []string{"COMPLETED"},
+		Refresh:    databaseBackupV1StateRefreshFunc(DatabaseV1Client, backup.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error waiting for openstack_db_backup_v1 %s to become ready: %s", backup.ID, err)
+	}
+
+	return resourceDatabaseBackupV1Read(ctx, d, meta)
+}
+
+func resourceDatabaseBackupV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	DatabaseV1Client, err := config.DatabaseV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack database client: %s", err)
+	}
+
+	backup, err := databaseBackupV1Get(DatabaseV1Client, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_db_backup_v1"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_db_backup_v1 %s: %#v", d.Id(), backup)
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("name", backup.Name)
+	d.Set("instance_id", backup.InstanceID)
+	d.Set("description", backup.Description)
+	d.Set("parent_id", backup.ParentID)
+	// Trove makes a full backup, when no backup exists to increment on, so
+	// the configured value is kept and only derived from parent_id on import.
+	if _, ok := d.GetOkExists("incremental"); !ok {
+		d.Set("incremental", backup.ParentID != "")
+	}
+	d.Set("size", backup.Size)
+	d.Set("status", backup.Status)
+	d.Set("location_ref", backup.LocationRef)
+	d.Set("created", backup.Created)
+	d.Set("updated", backup.Updated)
+
+	if err := d.Set("datastore", flattenDatabaseBackupV1Datastore(backup.Datastore)); err != nil {
+		return diag.Errorf("Unable to set openstack_db_backup_v1 datastore: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDatabaseBackupV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	DatabaseV1Client, err := config.DatabaseV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack database client: %s", err)
+	}
+
+	err = databaseBackupV1Delete(DatabaseV1Client, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_db_backup_v1"))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"COMPLETED", "DELETING"},
+		Target:     []string{"DELETED"},
+		Refresh:    databaseBackupV1StateRefreshFunc(DatabaseV1Client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error waiting for openstack_db_backup_v1 %s to Delete:  %s", d.Id(), err)
+	}
+
+	return nil
+}
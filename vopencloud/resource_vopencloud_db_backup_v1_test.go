@@ -0,0 +1,174 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/db/v1/instances"
+)
+
+func TestAccDatabaseV1Backup_basic(t *testing.T) {
+	var backup databaseBackupV1
+	var incremental databaseBackupV1
+	var restored instances.Instance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckDatabaseV1BackupDestroy,
+			testAccCheckDatabaseV1InstanceDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1BackupBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1BackupExists(
+						"openstack_db_backup_v1.full", &backup),
+					testAccCheckDatabaseV1BackupExists(
+						"openstack_db_backup_v1.incremental", &incremental),
+					resource.TestCheckResourceAttr(
+						"openstack_db_backup_v1.full", "name", "full"),
+					resource.TestCheckResourceAttr(
+						"openstack_db_backup_v1.full", "status", "COMPLETED"),
+					resource.TestCheckResourceAttr(
+						"openstack_db_backup_v1.full", "incremental", "false"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_db_backup_v1.incremental", "parent_id",
+						"openstack_db_backup_v1.full", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_db_backup_v1.incremental", "incremental", "true"),
+				),
+			},
+			{
+				Config: testAccDatabaseV1BackupRestore(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1InstanceExists(
+						"openstack_db_instance_v1.restored", &restored),
+					resource.TestCheckResourceAttrPair(
+						"openstack_db_instance_v1.restored", "restore_point.0.backup_ref",
+						"openstack_db_backup_v1.incremental", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDatabaseV1BackupExists(n string, backup *databaseBackupV1) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		DatabaseV1Client, err := config.DatabaseV1Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack database client: %s", err)
+		}
+
+		found, err := databaseBackupV1Get(DatabaseV1Client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Backup not found")
+		}
+
+		*backup = *found
+
+		return nil
+	}
+}
+
+func testAccCheckDatabaseV1BackupDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	DatabaseV1Client, err := config.DatabaseV1Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack database client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_db_backup_v1" {
+			continue
+		}
+
+		_, err := databaseBackupV1Get(DatabaseV1Client, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Backup still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccDatabaseV1BackupBasic() string {
+	return fmt.Sprintf(`
+resource "openstack_db_instance_v1" "basic" {
+  name = "basic"
+  size = 10
+
+  datastore {
+    version = "%[1]s"
+    type    = "%[2]s"
+  }
+
+  network {
+    uuid = "%[3]s"
+  }
+
+  database {
+    name = "testdb1"
+  }
+}
+
+resource "openstack_db_backup_v1" "full" {
+  name        = "full"
+  description = "full backup"
+  instance_id = openstack_db_instance_v1.basic.id
+}
+
+resource "openstack_db_backup_v1" "incremental" {
+  name        = "incremental"
+  instance_id = openstack_db_instance_v1.basic.id
+  parent_id   = openstack_db_backup_v1.full.id
+}
+`, osDBDatastoreVersion, osDBDatastoreType, osNetworkID)
+}
+
+func testAccDatabaseV1BackupRestore() string {
+	return fmt.Sprintf(`
+%[4]s
+
+resource "openstack_db_instance_v1" "restored" {
+  name = "restored"
+  size = 10
+
+  datastore {
+    version = "%[1]s"
+    type    = "%[2]s"
+  }
+
+  network {
+    uuid = "%[3]s"
+  }
+
+  restore_point {
+    backup_ref = openstack_db_backup_v1.incremental.id
+  }
+}
+`, osDBDatastoreVersion, osDBDatastoreType, osNetworkID, testAccDatabaseV1BackupBasic())
+}
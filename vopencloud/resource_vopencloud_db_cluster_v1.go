@@ -0,0 +1,329 @@
+package vopencloud
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+func resourceDatabaseClusterV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDatabaseClusterV1Create,
+		ReadContext:   resourceDatabaseClusterV1Read,
+		UpdateContext: resourceDatabaseClusterV1Update,
+		DeleteContext: resourceDatabaseClusterV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"datastore": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"flavor_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"size": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"instance_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"network": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uuid": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"locality": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"affinity", "anti-affinity",
+				}, false),
+			},
+
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceDatabaseClusterV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	DatabaseV1Client, err := config.DatabaseV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack database client: %s", err)
+	}
+
+	datastore := expandDatabaseInstanceV1Datastore(d.Get("datastore").([]interface{}))
+	createOpts := databaseClusterV1CreateOpts{
+		Name: d.Get("name").(string),
+		Datastore: databaseClusterV1Datastore{
+			Type:    datastore.Type,
+			Version: datastore.Version,
+		},
+		Instances: expandDatabaseClusterV1InstanceOpts(
+			d.Get("flavor_id").(string),
+			d.Get("size").(int),
+			d.Get("network").([]interface{}),
+			d.Get("availability_zone").(string),
+			d.Get("instance_count").(int),
+		),
+		Locality: d.Get("locality").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_db_cluster_v1 create options: %#v", createOpts)
+
+	cluster, err := databaseClusterV1Create(DatabaseV1Client, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_db_cluster_v1: %s", err)
+	}
+
+	d.SetId(cluster.ID)
+
+	err = databaseClusterV1WaitForIdle(ctx, DatabaseV1Client, cluster.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("Error waiting for openstack_db_cluster_v1 %s to become ready: %s", cluster.ID, err)
+	}
+
+	return resourceDatabaseClusterV1Read(ctx, d, meta)
+}
+
+func resourceDatabaseClusterV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	DatabaseV1Client, err := config.DatabaseV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack database client: %s", err)
+	}
+
+	cluster, err := databaseClusterV1Get(DatabaseV1Client, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_db_cluster_v1"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_db_cluster_v1 %s: %#v", d.Id(), cluster)
+
+	members := databaseClusterV1Members(cluster)
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("name", cluster.Name)
+	d.Set("instance_count", len(members))
+	d.Set("addresses", cluster.IP)
+
+	datastore := []map[string]interface{}{
+		{
+			"version": cluster.Datastore.Version,
+			"type":    cluster.Datastore.Type,
+		},
+	}
+	if err := d.Set("datastore", datastore); err != nil {
+		return diag.Errorf("Unable to set openstack_db_cluster_v1 datastore: %s", err)
+	}
+
+	if err := d.Set("instances", flattenDatabaseClusterV1Instances(cluster.Instances)); err != nil {
+		return diag.Errorf("Unable to set openstack_db_cluster_v1 instances: %s", err)
+	}
+
+	// All members share the same flavor and volume size.
+	if len(members) > 0 {
+		if members[0].Flavor != nil {
+			d.Set("flavor_id", members[0].Flavor.ID)
+		}
+		if members[0].Volume != nil {
+			d.Set("size", members[0].Volume.Size)
+		}
+	}
+
+	return nil
+}
+
+func resourceDatabaseClusterV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	DatabaseV1Client, err := config.DatabaseV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack database client: %s", err)
+	}
+
+	if d.HasChange("instance_count") {
+		cluster, err := databaseClusterV1Get(DatabaseV1Client, d.Id())
+		if err != nil {
+			return diag.Errorf("Error retrieving openstack_db_cluster_v1 %s: %s", d.Id(), err)
+		}
+
+		current := len(databaseClusterV1Members(cluster))
+		count := d.Get("instance_count").(int)
+
+		switch {
+		case count > current:
+			log.Printf("[DEBUG] Growing openstack_db_cluster_v1 %s from %d to %d instances", d.Id(), current, count)
+
+			growOpts := expandDatabaseClusterV1InstanceOpts(
+				d.Get("flavor_id").(string),
+				d.Get("size").(int),
+				d.Get("network").([]interface{}),
+				d.Get("availability_zone").(string),
+				count-current,
+			)
+			err = databaseClusterV1Grow(DatabaseV1Client, d.Id(), growOpts)
+		case count < current:
+			log.Printf("[DEBUG] Shrinking openstack_db_cluster_v1 %s from %d to %d instances", d.Id(), current, count)
+
+			err = databaseClusterV1Shrink(DatabaseV1Client, d.Id(), databaseClusterV1ShrinkIDs(cluster, count))
+		}
+		if err != nil {
+			return diag.Errorf("Error scaling openstack_db_cluster_v1 %s: %s", d.Id(), err)
+		}
+
+		err = databaseClusterV1WaitForIdle(ctx, DatabaseV1Client, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("Error waiting for openstack_db_cluster_v1 %s to scale: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDatabaseClusterV1Read(ctx, d, meta)
+}
+
+func resourceDatabaseClusterV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	DatabaseV1Client, err := config.DatabaseV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack database client: %s", err)
+	}
+
+	err = databaseClusterV1Delete(DatabaseV1Client, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_db_cluster_v1"))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"NONE", "DELETING"},
+		Target:     []string{"DELETED"},
+		Refresh:    databaseClusterV1StateRefreshFunc(DatabaseV1Client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error waiting for openstack_db_cluster_v1 %s to Delete:  %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// databaseClusterV1WaitForIdle waits for a Trove cluster to finish its
+// current task.
+func databaseClusterV1WaitForIdle(ctx context.Context, client *gophercloud.ServiceClient, clusterID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"BUILDING", "BUILDING_INITIAL", "GROWING_CLUSTER", "SHRINKING_CLUSTER",
+			"UPGRADING_CLUSTER", "RESTARTING_CLUSTER", "ADDING_SHARD"},
+		Target:     []string{"NONE"},
+		Refresh:    databaseClusterV1StateRefreshFunc(client, clusterID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
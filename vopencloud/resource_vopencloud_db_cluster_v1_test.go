@@ -0,0 +1,132 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDatabaseV1Cluster_basic(t *testing.T) {
+	var cluster databaseClusterV1
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabaseCluster(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDatabaseV1ClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1ClusterBasic(3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1ClusterExists(
+						"openstack_db_cluster_v1.basic", &cluster),
+					resource.TestCheckResourceAttr(
+						"openstack_db_cluster_v1.basic", "name", "basic"),
+					resource.TestCheckResourceAttr(
+						"openstack_db_cluster_v1.basic", "instance_count", "3"),
+				),
+			},
+			{
+				Config: testAccDatabaseV1ClusterBasic(4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1ClusterExists(
+						"openstack_db_cluster_v1.basic", &cluster),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_db_cluster_v1.basic", "id", &cluster.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_db_cluster_v1.basic", "instance_count", "4"),
+				),
+			},
+			{
+				Config: testAccDatabaseV1ClusterBasic(3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1ClusterExists(
+						"openstack_db_cluster_v1.basic", &cluster),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_db_cluster_v1.basic", "id", &cluster.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_db_cluster_v1.basic", "instance_count", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDatabaseV1ClusterExists(n string, cluster *databaseClusterV1) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		DatabaseV1Client, err := config.DatabaseV1Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack database client: %s", err)
+		}
+
+		found, err := databaseClusterV1Get(DatabaseV1Client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Cluster not found")
+		}
+
+		*cluster = *found
+
+		return nil
+	}
+}
+
+func testAccCheckDatabaseV1ClusterDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	DatabaseV1Client, err := config.DatabaseV1Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack database client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_db_cluster_v1" {
+			continue
+		}
+
+		_, err := databaseClusterV1Get(DatabaseV1Client, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Cluster still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccDatabaseV1ClusterBasic(count int) string {
+	return fmt.Sprintf(`
+resource "openstack_db_cluster_v1" "basic" {
+  name           = "basic"
+  flavor_id      = "%[4]s"
+  size           = 5
+  instance_count = %[5]d
+
+  datastore {
+    version = "%[1]s"
+    type    = "%[2]s"
+  }
+
+  network {
+    uuid = "%[3]s"
+  }
+}
+`, osDBClusterDatastoreVersion, osDBClusterDatastoreType, osNetworkID, osFlavorID, count)
+}
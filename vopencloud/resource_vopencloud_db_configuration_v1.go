@@ -17,6 +17,9 @@ func resourceDatabaseConfigurationV1() *schema.Resource {
 		CreateContext: resourceDatabaseConfigurationV1Create,
 		ReadContext:   resourceDatabaseConfigurationV1Read,
 		DeleteContext: resourceDatabaseConfigurationV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -65,7 +68,7 @@ func resourceDatabaseConfigurationV1() *schema.Resource {
 			},
 
 			"configuration": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Optional: true,
 				ForceNew: true,
 				Elem: &schema.Resource{
@@ -112,7 +115,7 @@ func resourceDatabaseConfigurationV1Create(ctx context.Context, d *schema.Resour
 
 	values := make(map[string]interface{})
 	if v, ok := d.GetOk("configuration"); ok {
-		values = expandDatabaseConfigurationV1Values(v.([]interface{}))
+		values = expandDatabaseConfigurationV1Values(v.(*schema.Set).List())
 	}
 	createOpts.Values = values
 
@@ -161,6 +164,15 @@ func resourceDatabaseConfigurationV1Read(_ context.Context, d *schema.ResourceDa
 	d.Set("description", cgroup.Description)
 	d.Set("region", GetRegion(d, config))
 
+	if err := d.Set("datastore", flattenDatabaseConfigurationV1Datastore(cgroup)); err != nil {
+		return diag.Errorf("Unable to set openstack_db_configuration_v1 datastore: %s", err)
+	}
+
+	values := flattenDatabaseConfigurationV1Values(cgroup.Values, d.Get("configuration").(*schema.Set).List())
+	if err := d.Set("configuration", values); err != nil {
+		return diag.Errorf("Unable to set openstack_db_configuration_v1 configuration: %s", err)
+	}
+
 	return nil
 }
 
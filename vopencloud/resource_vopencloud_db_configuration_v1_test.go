@@ -29,10 +29,11 @@ func TestAccDatabaseV1Configuration_basic(t *testing.T) {
 						"openstack_db_configuration_v1.basic", &configuration),
 					resource.TestCheckResourceAttr(
 						"openstack_db_configuration_v1.basic", "name", "basic"),
-					resource.TestCheckResourceAttr(
-						"openstack_db_configuration_v1.basic", "configuration.2.name", "max_connections"),
-					resource.TestCheckResourceAttr(
-						"openstack_db_configuration_v1.basic", "configuration.2.value", "200"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"openstack_db_configuration_v1.basic", "configuration.*", map[string]string{
+							"name":  "max_connections",
+							"value": "200",
+						}),
 				),
 			},
 		},
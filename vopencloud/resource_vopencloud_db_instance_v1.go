@@ -2,12 +2,15 @@ package vopencloud
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/gophercloud/gophercloud/openstack/db/v1/databases"
 	"github.com/gophercloud/gophercloud/openstack/db/v1/instances"
@@ -20,12 +23,27 @@ func resourceDatabaseInstanceV1() *schema.Resource {
 		ReadContext:   resourceDatabaseInstanceV1Read,
 		DeleteContext: resourceDatabaseInstanceV1Delete,
 		UpdateContext: resourceDatabaseInstanceUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDatabaseInstanceV1Import,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.All(
+			customdiff.ForceNewIfChange("size", func(ctx context.Context, old, new, meta interface{}) bool {
+				// Trove supports only growing the volume of an instance.
+				return new.(int) < old.(int)
+			}),
+			customdiff.ForceNewIfChange("replica_of", func(ctx context.Context, old, new, meta interface{}) bool {
+				// A replica can only be detached or promoted in place.
+				return new.(string) != ""
+			}),
+		),
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -42,7 +60,6 @@ func resourceDatabaseInstanceV1() *schema.Resource {
 			"flavor_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Computed:    true,
 				DefaultFunc: schema.EnvDefaultFunc("OS_FLAVOR_ID", nil),
 			},
@@ -50,7 +67,6 @@ func resourceDatabaseInstanceV1() *schema.Resource {
 			"size": {
 				Type:     schema.TypeInt,
 				Required: true,
-				ForceNew: true,
 			},
 
 			"datastore": {
@@ -161,6 +177,36 @@ func resourceDatabaseInstanceV1() *schema.Resource {
 				},
 			},
 
+			"restore_point": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_ref": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"replica_of": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"replica_detach_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "detach",
+				ValidateFunc: validation.StringInSlice([]string{
+					"detach", "promote",
+				}, false),
+			},
+
 			"configuration_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -168,6 +214,11 @@ func resourceDatabaseInstanceV1() *schema.Resource {
 				ForceNew: false,
 			},
 
+			"restart_on_configuration_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"addresses": {
 				Type:     schema.TypeList,
 				Optional: false,
@@ -222,9 +273,21 @@ func resourceDatabaseInstanceV1Create(ctx context.Context, d *schema.ResourceDat
 	}
 	createOpts.Users = userList
 
-	log.Printf("[DEBUG] openstack_db_instance_v1 create options: %#v", createOpts)
+	// restore point
+	var restorePoint string
+	if v, ok := d.GetOk("restore_point"); ok {
+		restorePoint = expandDatabaseInstanceV1RestorePoint(v.([]interface{}))
+	}
 
-	instance, err := instances.Create(DatabaseV1Client, createOpts).Extract()
+	createOptsExt := databaseInstanceV1CreateOptsExt{
+		CreateOptsBuilder: createOpts,
+		RestorePoint:      restorePoint,
+		ReplicaOf:         d.Get("replica_of").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_db_instance_v1 create options: %#v", createOptsExt)
+
+	instance, err := instances.Create(DatabaseV1Client, createOptsExt).Extract()
 	if err != nil {
 		return diag.Errorf("Error creating openstack_db_instance_v1: %s", err)
 	}
@@ -253,6 +316,13 @@ func resourceDatabaseInstanceV1Create(ctx context.Context, d *schema.ResourceDat
 			return diag.Errorf("error attaching configuration group %s to openstack_db_instance_v1 %s: %s",
 				configuration, instance.ID, err)
 		}
+
+		if d.Get("restart_on_configuration_change").(bool) {
+			err = databaseInstanceV1RestartIfRequired(ctx, DatabaseV1Client, instance.ID, d.Timeout(schema.TimeoutCreate))
+			if err != nil {
+				return diag.Errorf("Error waiting for openstack_db_instance_v1 %s to restart: %s", instance.ID, err)
+			}
+		}
 	}
 
 	// Store the ID now
@@ -268,19 +338,52 @@ func resourceDatabaseInstanceV1Read(_ context.Context, d *schema.ResourceData, m
 		return diag.Errorf("Error creating OpenStack database client: %s", err)
 	}
 
-	instance, err := instances.Get(DatabaseV1Client, d.Id()).Extract()
+	r := instances.Get(DatabaseV1Client, d.Id())
+	instance, err := r.Extract()
 	if err != nil {
 		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_db_instance_v1"))
 	}
 
+	details, err := databaseInstanceV1ExtractDetails(r)
+	if err != nil {
+		return diag.Errorf("Error extracting openstack_db_instance_v1 %s details: %s", d.Id(), err)
+	}
+
 	log.Printf("[DEBUG] Retrieved openstack_db_instance_v1 %s: %#v", d.Id(), instance)
 
 	d.Set("name", instance.Name)
-	d.Set("flavor_id", instance.Flavor)
-	d.Set("datastore", instance.Datastore)
+	d.Set("flavor_id", instance.Flavor.ID)
+	d.Set("size", instance.Volume.Size)
 	d.Set("region", GetRegion(d, config))
 	d.Set("addresses", instance.IP)
 
+	if err := d.Set("datastore", flattenDatabaseInstanceV1Datastore(instance.Datastore)); err != nil {
+		return diag.Errorf("Unable to set openstack_db_instance_v1 datastore: %s", err)
+	}
+
+	// network isn't computed, so that it's only read from the API on import.
+	networks := databaseInstanceV1CurrentNetworks(details, d.Get("network").([]interface{}))
+	if err := d.Set("network", networks); err != nil {
+		return diag.Errorf("Unable to set openstack_db_instance_v1 network: %s", err)
+	}
+
+	if details.Configuration != nil {
+		d.Set("configuration_id", details.Configuration.ID)
+	} else {
+		d.Set("configuration_id", "")
+	}
+
+	// When a replica is promoted, its former source becomes a replica of the
+	// promoted instance. replica_of isn't set on an instance, which isn't
+	// managed as a replica, to not detach the former source on the next apply.
+	if details.ReplicaOf == nil {
+		d.Set("replica_of", "")
+	} else if d.Get("replica_of").(string) != "" {
+		d.Set("replica_of", details.ReplicaOf.ID)
+	} else {
+		log.Printf("[DEBUG] openstack_db_instance_v1 %s is a replica of %s, which isn't managed", d.Id(), details.ReplicaOf.ID)
+	}
+
 	return nil
 }
 
@@ -291,6 +394,58 @@ func resourceDatabaseInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("Error creating OpenStack database client: %s", err)
 	}
 
+	if d.HasChange("replica_of") {
+		// Changing replica_of to another instance forces a new resource,
+		// so the replica is removed from its source here.
+		o, _ := d.GetChange("replica_of")
+
+		if d.Get("replica_detach_mode").(string) == "promote" {
+			log.Printf("[DEBUG] Promoting openstack_db_instance_v1 %s to replace its source %s", d.Id(), o)
+			err = databaseInstanceV1PromoteReplica(DatabaseV1Client, d.Id())
+		} else {
+			log.Printf("[DEBUG] Detaching openstack_db_instance_v1 %s from its source %s", d.Id(), o)
+			err = databaseInstanceV1DetachReplica(DatabaseV1Client, d.Id())
+		}
+		if err != nil {
+			return diag.Errorf("Error removing openstack_db_instance_v1 %s from its source %s: %s", d.Id(), o, err)
+		}
+
+		err = databaseInstanceV1WaitForActive(ctx, DatabaseV1Client, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("Error waiting for openstack_db_instance_v1 %s to be removed from its source: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("flavor_id") {
+		flavorID := d.Get("flavor_id").(string)
+		log.Printf("[DEBUG] Resizing openstack_db_instance_v1 %s to flavor %s", d.Id(), flavorID)
+
+		err := instances.Resize(DatabaseV1Client, d.Id(), flavorID).ExtractErr()
+		if err != nil {
+			return diag.Errorf("Error resizing openstack_db_instance_v1 %s: %s", d.Id(), err)
+		}
+
+		err = databaseInstanceV1WaitForActive(ctx, DatabaseV1Client, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("Error waiting for openstack_db_instance_v1 %s to resize: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("size") {
+		size := d.Get("size").(int)
+		log.Printf("[DEBUG] Resizing openstack_db_instance_v1 %s volume to %d GB", d.Id(), size)
+
+		err := instances.ResizeVolume(DatabaseV1Client, d.Id(), size).ExtractErr()
+		if err != nil {
+			return diag.Errorf("Error resizing openstack_db_instance_v1 %s volume: %s", d.Id(), err)
+		}
+
+		err = databaseInstanceV1WaitForActive(ctx, DatabaseV1Client, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("Error waiting for openstack_db_instance_v1 %s volume to resize: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("configuration_id") {
 		o, n := d.GetChange("configuration_id")
 
@@ -307,6 +462,13 @@ func resourceDatabaseInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 			}
 			log.Printf("Attaching configuration to openstack_db_instance_v1 %s", d.Id())
 		}
+
+		if d.Get("restart_on_configuration_change").(bool) {
+			err = databaseInstanceV1RestartIfRequired(ctx, DatabaseV1Client, d.Id(), d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.Errorf("Error waiting for openstack_db_instance_v1 %s to restart: %s", d.Id(), err)
+			}
+		}
 	}
 
 	return resourceDatabaseInstanceV1Read(ctx, d, meta)
@@ -340,3 +502,26 @@ func resourceDatabaseInstanceV1Delete(ctx context.Context, d *schema.ResourceDat
 
 	return nil
 }
+
+func resourceDatabaseInstanceV1Import(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	DatabaseV1Client, err := config.DatabaseV1Client(GetRegion(d, config))
+	if err != nil {
+		return nil, fmt.Errorf("Error creating OpenStack database client: %s", err)
+	}
+
+	details, err := databaseInstanceV1ExtractDetails(instances.Get(DatabaseV1Client, d.Id()))
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving openstack_db_instance_v1 %s: %s", d.Id(), err)
+	}
+
+	if details.ReplicaOf != nil {
+		d.Set("replica_of", details.ReplicaOf.ID)
+	}
+
+	if err := d.Set("network", flattenDatabaseInstanceV1Networks(details)); err != nil {
+		return nil, fmt.Errorf("Unable to set openstack_db_instance_v1 network: %s", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -57,6 +57,79 @@ func TestAccDatabaseV1Instance_basic(t *testing.T) {
 	})
 }
 
+func TestAccDatabaseV1Instance_resize(t *testing.T) {
+	var instance instances.Instance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDatabaseV1InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1InstanceResize(10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1InstanceExists(
+						"openstack_db_instance_v1.basic", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_db_instance_v1.basic", "size", "10"),
+				),
+			},
+			{
+				Config: testAccDatabaseV1InstanceResize(11),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1InstanceExists(
+						"openstack_db_instance_v1.basic", &instance),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_db_instance_v1.basic", "id", &instance.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_db_instance_v1.basic", "size", "11"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatabaseV1Instance_replica(t *testing.T) {
+	var replica instances.Instance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDatabaseV1InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1InstanceReplica(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1InstanceExists(
+						"openstack_db_instance_v1.replica", &replica),
+					resource.TestCheckResourceAttrPair(
+						"openstack_db_instance_v1.replica", "replica_of",
+						"openstack_db_instance_v1.basic", "id"),
+				),
+			},
+			{
+				Config: testAccDatabaseV1InstanceReplica(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1InstanceExists(
+						"openstack_db_instance_v1.replica", &replica),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_db_instance_v1.replica", "id", &replica.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_db_instance_v1.replica", "replica_of", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDatabaseV1InstanceExists(n string, instance *instances.Instance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -175,3 +248,77 @@ resource "openstack_db_configuration_v1" "basic" {
 }
 `, osDBDatastoreVersion, osDBDatastoreType, osNetworkID)
 }
+
+func testAccDatabaseV1InstanceResize(size int) string {
+	return fmt.Sprintf(`
+resource "openstack_db_instance_v1" "basic" {
+  name                            = "basic"
+  configuration_id                = openstack_db_configuration_v1.basic.id
+  restart_on_configuration_change = true
+
+  datastore {
+    version = "%[1]s"
+    type    = "%[2]s"
+  }
+
+  network {
+    uuid = "%[3]s"
+  }
+
+  size = %[4]d
+}
+
+resource "openstack_db_configuration_v1" "basic" {
+  name        = "basic"
+  description = "test"
+
+  datastore {
+    version = "%[1]s"
+    type    = "%[2]s"
+  }
+
+  configuration {
+    name  = "max_connections"
+    value = 200
+  }
+}
+`, osDBDatastoreVersion, osDBDatastoreType, osNetworkID, size)
+}
+
+func testAccDatabaseV1InstanceReplica(attached bool) string {
+	replicaOf := ""
+	if attached {
+		replicaOf = "replica_of = openstack_db_instance_v1.basic.id"
+	}
+
+	return fmt.Sprintf(`
+resource "openstack_db_instance_v1" "basic" {
+  name = "basic"
+  size = 10
+
+  datastore {
+    version = "%[1]s"
+    type    = "%[2]s"
+  }
+
+  network {
+    uuid = "%[3]s"
+  }
+}
+
+resource "openstack_db_instance_v1" "replica" {
+  name = "replica"
+  size = 10
+  %[4]s
+
+  datastore {
+    version = "%[1]s"
+    type    = "%[2]s"
+  }
+
+  network {
+    uuid = "%[3]s"
+  }
+}
+`, osDBDatastoreVersion, osDBDatastoreType, osNetworkID, replicaOf)
+}
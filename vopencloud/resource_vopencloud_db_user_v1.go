@@ -18,6 +18,9 @@ func resourceDatabaseUserV1() *schema.Resource {
 		CreateContext: resourceDatabaseUserV1Create,
 		ReadContext:   resourceDatabaseUserV1Read,
 		DeleteContext: resourceDatabaseUserV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -138,6 +141,8 @@ func resourceDatabaseUserV1Read(_ context.Context, d *schema.ResourceData, meta
 	}
 
 	d.Set("name", userName)
+	d.Set("instance_id", instanceID)
+	d.Set("region", GetRegion(d, config))
 
 	databases := flattenDatabaseUserV1Databases(userObj.Databases)
 	if err := d.Set("databases", databases); err != nil {
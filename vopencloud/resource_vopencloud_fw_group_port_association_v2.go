@@ -0,0 +1,157 @@
+package vopencloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/fwaas_v2/groups"
+)
+
+func resourceFWGroupPortAssociationV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFWGroupPortAssociationV2Create,
+		ReadContext:   resourceFWGroupPortAssociationV2Read,
+		DeleteContext: resourceFWGroupPortAssociationV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"port_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceFWGroupPortAssociationV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	groupID := d.Get("group_id").(string)
+	portID := d.Get("port_id").(string)
+	config.MutexKV.Lock(groupID)
+	defer config.MutexKV.Unlock(groupID)
+
+	group, err := groups.Get(networkingClient, groupID).Extract()
+	if err != nil {
+		return diag.Errorf("Error retrieving openstack_fw_group_v2 %s: %s", groupID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_fw_group_v2 %s: %#v", groupID, group)
+
+	id := fmt.Sprintf("%s/%s", groupID, portID)
+	if strSliceContains(group.Ports, portID) {
+		log.Printf("[DEBUG] openstack_fw_group_v2 %s already has port %s", groupID, portID)
+	} else {
+		ports := append(group.Ports, portID)
+		err = fwGroupV2UpdatePorts(ctx, networkingClient, groupID, ports, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.Errorf("Error creating openstack_fw_group_port_association_v2 %s: %s", id, err)
+		}
+	}
+
+	d.SetId(id)
+
+	return resourceFWGroupPortAssociationV2Read(ctx, d, meta)
+}
+
+func resourceFWGroupPortAssociationV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	groupID, portID, err := parseFWGroupPortAssociationV2ID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	group, err := groups.Get(networkingClient, groupID).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_fw_group_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_fw_group_v2 %s: %#v", groupID, group)
+
+	if !strSliceContains(group.Ports, portID) {
+		log.Printf("[DEBUG] openstack_fw_group_port_association_v2 %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("group_id", groupID)
+	d.Set("port_id", portID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceFWGroupPortAssociationV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	groupID, portID, err := parseFWGroupPortAssociationV2ID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	config.MutexKV.Lock(groupID)
+	defer config.MutexKV.Unlock(groupID)
+
+	group, err := groups.Get(networkingClient, groupID).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_fw_group_v2"))
+	}
+
+	ports := make([]string, 0, len(group.Ports))
+	for _, v := range group.Ports {
+		if v != portID {
+			ports = append(ports, v)
+		}
+	}
+
+	if len(ports) == len(group.Ports) {
+		log.Printf("[DEBUG] openstack_fw_group_port_association_v2 %s already removed", d.Id())
+		return nil
+	}
+
+	err = fwGroupV2UpdatePorts(ctx, networkingClient, groupID, ports, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("Error deleting openstack_fw_group_port_association_v2 %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package vopencloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/fwaas_v2/groups"
+)
+
+func TestAccFWGroupPortAssociationV2_basic(t *testing.T) {
+	var group groups.Group
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckFW(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckFWGroupV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFWGroupPortAssociationV2Basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFWGroupV2Exists("openstack_fw_group_v2.group_1", &group),
+					testAccCheckFWGroupPortCount(&group, 1),
+					resource.TestCheckResourceAttrPair(
+						"openstack_fw_group_port_association_v2.association_1", "port_id",
+						"openstack_networking_router_interface_v2.router_interface_1", "port_id"),
+				),
+			},
+			{
+				Config: testAccFWGroupPortAssociationV2Remove,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFWGroupV2Exists("openstack_fw_group_v2.group_1", &group),
+					testAccCheckFWGroupPortCount(&group, 0),
+				),
+			},
+		},
+	})
+}
+
+const testAccFWGroupPortAssociationV2Group = `
+resource "openstack_networking_router_v2" "router_1" {
+  name           = "router_1"
+  admin_state_up = true
+}
+
+resource "openstack_networking_network_v2" "network_1" {
+  name           = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  cidr       = "10.20.30.0/24"
+  ip_version = 4
+}
+
+resource "openstack_networking_router_interface_v2" "router_interface_1" {
+  router_id = "${openstack_networking_router_v2.router_1.id}"
+  subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "openstack_fw_group_v2" "group_1" {
+  name        = "group_1"
+  description = "firewall group port association test"
+
+  lifecycle {
+    ignore_changes = [ports]
+  }
+}
+`
+
+const testAccFWGroupPortAssociationV2Basic = testAccFWGroupPortAssociationV2Group + `
+resource "openstack_fw_group_port_association_v2" "association_1" {
+  group_id = "${openstack_fw_group_v2.group_1.id}"
+  port_id  = "${openstack_networking_router_interface_v2.router_interface_1.port_id}"
+}
+`
+
+const testAccFWGroupPortAssociationV2Remove = testAccFWGroupPortAssociationV2Group
@@ -0,0 +1,161 @@
+package vopencloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/fwaas_v2/policies"
+)
+
+func resourceFWPolicyRuleAssociationV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFWPolicyRuleAssociationV2Create,
+		ReadContext:   resourceFWPolicyRuleAssociationV2Read,
+		DeleteContext: resourceFWPolicyRuleAssociationV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"rule_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"insert_before": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"insert_after"},
+			},
+
+			"insert_after": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"insert_before"},
+			},
+
+			"position": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceFWPolicyRuleAssociationV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID := d.Get("policy_id").(string)
+	ruleID := d.Get("rule_id").(string)
+	config.MutexKV.Lock(policyID)
+	defer config.MutexKV.Unlock(policyID)
+
+	insertOpts := fwPolicyV2InsertRuleOpts{
+		ID:           ruleID,
+		InsertBefore: d.Get("insert_before").(string),
+		InsertAfter:  d.Get("insert_after").(string),
+	}
+
+	id := fmt.Sprintf("%s/%s", policyID, ruleID)
+	log.Printf("[DEBUG] openstack_fw_policy_rule_association_v2 create options: %#v", insertOpts)
+	_, err = policies.InsertRule(networkingClient, policyID, insertOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_fw_policy_rule_association_v2 %s: %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceFWPolicyRuleAssociationV2Read(ctx, d, meta)
+}
+
+func resourceFWPolicyRuleAssociationV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID, ruleID, err := parseFWPolicyRuleAssociationV2ID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy, err := policies.Get(networkingClient, policyID).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_fw_policy_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_fw_policy_v2 %s: %#v", policyID, policy)
+
+	position := fwPolicyV2RulePosition(policy.Rules, ruleID)
+	if position == 0 {
+		log.Printf("[DEBUG] openstack_fw_policy_rule_association_v2 %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("policy_id", policyID)
+	d.Set("rule_id", ruleID)
+	d.Set("position", position)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceFWPolicyRuleAssociationV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID, ruleID, err := parseFWPolicyRuleAssociationV2ID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	config.MutexKV.Lock(policyID)
+	defer config.MutexKV.Unlock(policyID)
+
+	policy, err := policies.Get(networkingClient, policyID).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_fw_policy_v2"))
+	}
+
+	if fwPolicyV2RulePosition(policy.Rules, ruleID) == 0 {
+		log.Printf("[DEBUG] openstack_fw_policy_rule_association_v2 %s already removed", d.Id())
+		return nil
+	}
+
+	log.Printf("[DEBUG] Removing rule %s from openstack_fw_policy_v2 %s", ruleID, policyID)
+	_, err = policies.RemoveRule(networkingClient, policyID, ruleID).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_fw_policy_rule_association_v2"))
+	}
+
+	return nil
+}
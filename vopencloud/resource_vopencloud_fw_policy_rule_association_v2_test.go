@@ -0,0 +1,118 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/fwaas_v2/policies"
+)
+
+func TestAccFWPolicyRuleAssociationV2_basic(t *testing.T) {
+	var policy policies.Policy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckFW(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckFWPolicyV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFWPolicyRuleAssociationV2Basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFWPolicyV2Exists("openstack_fw_policy_v2.policy_1", &policy),
+					resource.TestCheckResourceAttr(
+						"openstack_fw_policy_rule_association_v2.association_1", "position", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_fw_policy_rule_association_v2.association_2", "position", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_fw_policy_rule_association_v2.association_3", "position", "2"),
+				),
+			},
+			{
+				Config: testAccFWPolicyRuleAssociationV2Remove,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFWPolicyV2Exists("openstack_fw_policy_v2.policy_1", &policy),
+					testAccCheckFWPolicyRuleAssociationV2Count(&policy, 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFWPolicyRuleAssociationV2Count(policy *policies.Policy, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(policy.Rules) != expected {
+			return fmt.Errorf("Expected %d Rules, got %d", expected, len(policy.Rules))
+		}
+
+		return nil
+	}
+}
+
+const testAccFWPolicyRuleAssociationV2Basic = `
+resource "openstack_fw_rule_v2" "rule_1" {
+  protocol = "tcp"
+  action   = "allow"
+}
+
+resource "openstack_fw_rule_v2" "rule_2" {
+  protocol = "udp"
+  action   = "deny"
+}
+
+resource "openstack_fw_rule_v2" "rule_3" {
+  protocol = "icmp"
+  action   = "deny"
+}
+
+resource "openstack_fw_policy_v2" "policy_1" {
+  name = "policy_1"
+
+  lifecycle {
+    ignore_changes = [rules]
+  }
+}
+
+resource "openstack_fw_policy_rule_association_v2" "association_1" {
+  policy_id = "${openstack_fw_policy_v2.policy_1.id}"
+  rule_id   = "${openstack_fw_rule_v2.rule_1.id}"
+}
+
+resource "openstack_fw_policy_rule_association_v2" "association_2" {
+  policy_id    = "${openstack_fw_policy_v2.policy_1.id}"
+  rule_id      = "${openstack_fw_rule_v2.rule_2.id}"
+  insert_after = "${openstack_fw_policy_rule_association_v2.association_1.rule_id}"
+}
+
+resource "openstack_fw_policy_rule_association_v2" "association_3" {
+  policy_id     = "${openstack_fw_policy_v2.policy_1.id}"
+  rule_id       = "${openstack_fw_rule_v2.rule_3.id}"
+  insert_before = "${openstack_fw_policy_rule_association_v2.association_2.rule_id}"
+}
+`
+
+const testAccFWPolicyRuleAssociationV2Remove = `
+resource "openstack_fw_rule_v2" "rule_1" {
+  protocol = "tcp"
+  action   = "allow"
+}
+
+resource "openstack_fw_policy_v2" "policy_1" {
+  name = "policy_1"
+
+  lifecycle {
+    ignore_changes = [rules]
+  }
+}
+
+resource "openstack_fw_policy_rule_association_v2" "association_1" {
+  policy_id = "${openstack_fw_policy_v2.policy_1.id}"
+  rule_id   = "${openstack_fw_rule_v2.rule_1.id}"
+}
+`
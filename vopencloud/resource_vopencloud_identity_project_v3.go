@@ -20,6 +20,8 @@ func resourceIdentityProjectV3() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customizeDiffObjectDefaultTags,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -71,6 +73,20 @@ func resourceIdentityProjectV3() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+
+			"all_tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"default_tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 		},
 	}
 }
@@ -93,9 +109,8 @@ func resourceIdentityProjectV3Create(ctx context.Context, d *schema.ResourceData
 		ParentID:    d.Get("parent_id").(string),
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		tags := v.(*schema.Set).List()
-		createOpts.Tags = expandToStringSlice(tags)
+	if tags := expandObjectCreateTags(d, config.DefaultTags); len(tags) > 0 {
+		createOpts.Tags = tags
 	}
 
 	log.Printf("[DEBUG] openstack_identity_project_v3 create options: %#v", createOpts)
@@ -130,7 +145,7 @@ func resourceIdentityProjectV3Read(ctx context.Context, d *schema.ResourceData,
 	d.Set("name", project.Name)
 	d.Set("parent_id", project.ParentID)
 	d.Set("region", GetRegion(d, config))
-	d.Set("tags", project.Tags)
+	expandObjectReadTagsWithImport(d, project.Tags, config.DefaultTags)
 
 	return nil
 }
@@ -178,15 +193,10 @@ func resourceIdentityProjectV3Update(ctx context.Context, d *schema.ResourceData
 		updateOpts.Description = &description
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "all_tags") {
 		hasChange = true
-		if v, ok := d.GetOk("tags"); ok {
-			tags := v.(*schema.Set).List()
-			tagsToUpdate := expandToStringSlice(tags)
-			updateOpts.Tags = &tagsToUpdate
-		} else {
-			updateOpts.Tags = &[]string{}
-		}
+		tags := expandObjectUpdateTags(d, config.DefaultTags)
+		updateOpts.Tags = &tags
 	}
 
 	if hasChange {
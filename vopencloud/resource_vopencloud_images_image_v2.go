@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -28,7 +29,10 @@ func resourceImagesImageV2() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: resourceImagesImageV2UpdateComputedAttributes,
+		CustomizeDiff: customdiff.Sequence(
+			resourceImagesImageV2UpdateComputedAttributes,
+			customizeDiffObjectDefaultTags,
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -146,6 +150,20 @@ func resourceImagesImageV2() *schema.Resource {
 				Set:      schema.HashString,
 			},
 
+			"all_tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"default_tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
 			"verify_checksum": {
 				Type:          schema.TypeBool,
 				Optional:      true,
@@ -263,9 +281,8 @@ func resourceImagesImageV2Create(ctx context.Context, d *schema.ResourceData, me
 		createOpts.Hidden = &hidden
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		tags := v.(*schema.Set).List()
-		createOpts.Tags = resourceImagesImageV2BuildTags(tags)
+	if tags := expandObjectCreateTags(d, config.DefaultTags); len(tags) > 0 {
+		createOpts.Tags = tags
 	}
 
 	d.Partial(true)
@@ -388,7 +405,7 @@ func resourceImagesImageV2Read(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("protected", img.Protected)
 	d.Set("hidden", img.Hidden)
 	d.Set("size_bytes", img.SizeBytes)
-	d.Set("tags", img.Tags)
+	expandObjectReadTagsWithImport(d, img.Tags, config.DefaultTags)
 	d.Set("visibility", img.Visibility)
 	d.Set("region", GetRegion(d, config))
 
@@ -447,10 +464,9 @@ func resourceImagesImageV2Update(ctx context.Context, d *schema.ResourceData, me
 		updateOpts = append(updateOpts, v)
 	}
 
-	if d.HasChange("tags") {
-		tags := d.Get("tags").(*schema.Set).List()
+	if d.HasChanges("tags", "all_tags") {
 		v := images.ReplaceImageTags{
-			NewTags: resourceImagesImageV2BuildTags(tags),
+			NewTags: expandObjectUpdateTags(d, config.DefaultTags),
 		}
 		updateOpts = append(updateOpts, v)
 	}
@@ -2,83 +2,295 @@ package vopencloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"net/http"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-//https://spacelift.io/blog/terraform-data-sources-how-they-are-utilised
-
 func resourceKubernetesV1() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: resourceKubernetesCreate,
-		ReadContext:   resourceKubernetesRead,
-		UpdateContext: resourceKubernetesUpdate,
-		DeleteContext: resourceKubernetesDelete,
+		CreateContext: resourceKubernetesV1Create,
+		ReadContext:   resourceKubernetesV1Read,
+		UpdateContext: resourceKubernetesV1Update,
+		DeleteContext: resourceKubernetesV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: false,
+				ForceNew: true,
 			},
-			"account": &schema.Schema{
+
+			"cluster_version": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: false,
 			},
+
+			"network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"node_pool": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"flavor_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"node_count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1,
+						},
+
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+
+						"labels": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"metadata": {
 				Type:     schema.TypeMap,
 				Optional: true,
-				ForceNew: false,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 		},
 	}
 }
 
-func resourceKubernetesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceKubernetesV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	userName := ""
+	kubernetesClient, err := config.KubernetesV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack kubernetes client: %s", err)
+	}
 
-	if config == nil {
-		fmt.Println("Config is nil")
-	} else {
-		//Ok con dê
-		userName = config.Username
-		region := config.Region
-		fmt.Println("UserName: ", userName)
-		fmt.Println("Region: ", region)
+	createOpts := kubernetesClusterV1CreateOpts{
+		Name:      d.Get("name").(string),
+		Version:   d.Get("cluster_version").(string),
+		NetworkID: d.Get("network_id").(string),
+		SubnetID:  d.Get("subnet_id").(string),
+		NodePools: expandKubernetesV1NodePools(d.Get("node_pool").([]interface{})),
+		Metadata:  expandToMapStringString(d.Get("metadata").(map[string]interface{})),
 	}
 
-	name := d.Get("name").(string)
-	account := d.Get("account").(string)
+	log.Printf("[DEBUG] openstack_kubernetes_v1 create options: %#v", createOpts)
+
+	c, err := kubernetesClusterV1Create(kubernetesClient, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_kubernetes_v1: %s", err)
+	}
 
-	d.SetId(account)
-	fmt.Println("Name: ", name)
+	d.SetId(c.ID)
 
-	//TODO: Call the real API with parameters
-	resp, err := http.Get("http://localhost:8088/k8s/parameters/" + userName)
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"CREATING", "PENDING"},
+		Target:       []string{"ACTIVE"},
+		Refresh:      kubernetesClusterV1StateRefreshFunc(kubernetesClient, c.ID),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        1 * time.Minute,
+		PollInterval: 20 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		log.Fatal(err)
+		return diag.Errorf(
+			"Error waiting for openstack_kubernetes_v1 %s to become ready: %s", c.ID, err)
 	}
 
-	defer resp.Body.Close()
+	log.Printf("[DEBUG] Created openstack_kubernetes_v1 %s", c.ID)
 
-	return resourceKubernetesRead(ctx, d, meta)
+	return resourceKubernetesV1Read(ctx, d, meta)
 }
 
-func resourceKubernetesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceKubernetesV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	kubernetesClient, err := config.KubernetesV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack kubernetes client: %s", err)
+	}
+
+	c, err := kubernetesClusterV1Get(kubernetesClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_kubernetes_v1"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_kubernetes_v1 %s: %#v", d.Id(), c)
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("name", c.Name)
+	d.Set("cluster_version", c.Version)
+	d.Set("network_id", c.NetworkID)
+	d.Set("subnet_id", c.SubnetID)
+	d.Set("metadata", c.Metadata)
+	d.Set("project_id", c.ProjectID)
+	d.Set("endpoint", c.Endpoint)
+	d.Set("status", c.Status)
+	d.Set("created_at", c.CreatedAt)
+	d.Set("updated_at", c.UpdatedAt)
+
+	if err := d.Set("node_pool", flattenKubernetesV1NodePools(c.NodePools)); err != nil {
+		return diag.Errorf("Unable to set openstack_kubernetes_v1 node_pool: %s", err)
+	}
+
 	return nil
 }
 
-func resourceKubernetesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return resourceKubernetesRead(ctx, d, meta)
+func resourceKubernetesV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	kubernetesClient, err := config.KubernetesV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack kubernetes client: %s", err)
+	}
+
+	var hasChange bool
+	var updateOpts kubernetesClusterV1UpdateOpts
+
+	if d.HasChange("cluster_version") {
+		hasChange = true
+		updateOpts.Version = d.Get("cluster_version").(string)
+	}
+
+	if d.HasChange("node_pool") {
+		hasChange = true
+		updateOpts.NodePools = expandKubernetesV1NodePools(d.Get("node_pool").([]interface{}))
+	}
+
+	if d.HasChange("metadata") {
+		hasChange = true
+		metadata := expandToMapStringString(d.Get("metadata").(map[string]interface{}))
+		updateOpts.Metadata = &metadata
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_kubernetes_v1 %s update options: %#v", d.Id(), updateOpts)
+
+		err = kubernetesClusterV1Update(kubernetesClient, d.Id(), updateOpts)
+		if err != nil {
+			return diag.Errorf("Error updating openstack_kubernetes_v1 %s: %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:      []string{"UPDATING", "UPGRADING", "SCALING", "PENDING"},
+			Target:       []string{"ACTIVE"},
+			Refresh:      kubernetesClusterV1StateRefreshFunc(kubernetesClient, d.Id()),
+			Timeout:      d.Timeout(schema.TimeoutUpdate),
+			Delay:        30 * time.Second,
+			PollInterval: 20 * time.Second,
+		}
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.Errorf(
+				"Error waiting for openstack_kubernetes_v1 %s to become updated: %s", d.Id(), err)
+		}
+	}
+
+	return resourceKubernetesV1Read(ctx, d, meta)
 }
 
-func resourceKubernetesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	d.SetId("")
+func resourceKubernetesV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	kubernetesClient, err := config.KubernetesV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack kubernetes client: %s", err)
+	}
+
+	if err := kubernetesClusterV1Delete(kubernetesClient, d.Id()); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_kubernetes_v1"))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"ACTIVE", "DELETING"},
+		Target:       []string{"DELETED"},
+		Refresh:      kubernetesClusterV1StateRefreshFunc(kubernetesClient, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        30 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf(
+			"Error waiting for openstack_kubernetes_v1 %s to become deleted: %s", d.Id(), err)
+	}
+
 	return nil
 }
@@ -0,0 +1,136 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKubernetesV1_basic(t *testing.T) {
+	var cluster kubernetesClusterV1
+
+	resourceName := "openstack_kubernetes_v1.cluster_1"
+	clusterName := acctest.RandomWithPrefix("tf-acc-kubernetes")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckKubernetes(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckKubernetesV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesV1Basic(clusterName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKubernetesV1Exists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "name", clusterName),
+					resource.TestCheckResourceAttr(resourceName, "cluster_version", osKubernetesVersion),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "node_pool.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "node_pool.0.node_count", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "project_id"),
+				),
+			},
+			{
+				Config: testAccKubernetesV1Basic(clusterName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKubernetesV1Exists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "node_pool.0.node_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesV1Exists(n string, cluster *kubernetesClusterV1) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		kubernetesClient, err := config.KubernetesV1Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack kubernetes client: %s", err)
+		}
+
+		found, err := kubernetesClusterV1Get(kubernetesClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Cluster not found")
+		}
+
+		*cluster = *found
+
+		return nil
+	}
+}
+
+func testAccCheckKubernetesV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	kubernetesClient, err := config.KubernetesV1Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack kubernetes client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_kubernetes_v1" {
+			continue
+		}
+
+		_, err := kubernetesClusterV1Get(kubernetesClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Cluster still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccKubernetesV1Basic(clusterName string, nodeCount int) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name           = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name       = "subnet_1"
+  cidr       = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_kubernetes_v1" "cluster_1" {
+  name            = "%s"
+  cluster_version = "%s"
+  network_id      = "${openstack_networking_network_v2.network_1.id}"
+  subnet_id       = "${openstack_networking_subnet_v2.subnet_1.id}"
+
+  node_pool {
+    name       = "default"
+    flavor_id  = "%s"
+    node_count = %d
+  }
+
+  metadata = {
+    foo = "bar"
+  }
+}
+`, clusterName, osKubernetesVersion, osFlavorID, nodeCount)
+}
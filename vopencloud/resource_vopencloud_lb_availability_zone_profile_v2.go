@@ -0,0 +1,160 @@
+package vopencloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+func resourceLBAvailabilityZoneProfileV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLBAvailabilityZoneProfileV2Create,
+		ReadContext:   resourceLBAvailabilityZoneProfileV2Read,
+		UpdateContext: resourceLBAvailabilityZoneProfileV2Update,
+		DeleteContext: resourceLBAvailabilityZoneProfileV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"provider_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"availability_zone_data": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: diffSuppressJSONObject,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceLBAvailabilityZoneProfileV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error creating openstack_lb_availability_zone_profile_v2: Only available when using octavia")
+	}
+
+	createOpts := lbAvailabilityZoneProfileV2CreateOpts{
+		Name:                 d.Get("name").(string),
+		ProviderName:         d.Get("provider_name").(string),
+		AvailabilityZoneData: d.Get("availability_zone_data").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_lb_availability_zone_profile_v2 create options: %#v", createOpts)
+
+	azp, err := lbAvailabilityZoneProfileV2Create(lbClient, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_lb_availability_zone_profile_v2: %s", err)
+	}
+
+	d.SetId(azp.ID)
+
+	return resourceLBAvailabilityZoneProfileV2Read(ctx, d, meta)
+}
+
+func resourceLBAvailabilityZoneProfileV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	azp, err := lbAvailabilityZoneProfileV2Get(lbClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_lb_availability_zone_profile_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_availability_zone_profile_v2 %s: %#v", d.Id(), azp)
+
+	d.Set("name", azp.Name)
+	d.Set("provider_name", azp.ProviderName)
+	d.Set("availability_zone_data", normalizeLBV2JSONData(azp.AvailabilityZoneData))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceLBAvailabilityZoneProfileV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	var (
+		hasChange  bool
+		updateOpts lbAvailabilityZoneProfileV2UpdateOpts
+	)
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("provider_name") {
+		hasChange = true
+		providerName := d.Get("provider_name").(string)
+		updateOpts.ProviderName = &providerName
+	}
+
+	if d.HasChange("availability_zone_data") {
+		hasChange = true
+		availabilityZoneData := d.Get("availability_zone_data").(string)
+		updateOpts.AvailabilityZoneData = &availabilityZoneData
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_lb_availability_zone_profile_v2 %s update options: %#v", d.Id(), updateOpts)
+
+		err = lbAvailabilityZoneProfileV2Update(lbClient, d.Id(), updateOpts)
+		if err != nil {
+			return diag.Errorf("Error updating openstack_lb_availability_zone_profile_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceLBAvailabilityZoneProfileV2Read(ctx, d, meta)
+}
+
+func resourceLBAvailabilityZoneProfileV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	err = lbAvailabilityZoneProfileV2Delete(lbClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_lb_availability_zone_profile_v2"))
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccLBAvailabilityZoneProfileV2_basic(t *testing.T) {
+	var azp lbAvailabilityZoneProfileV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBAvailabilityZoneProfileV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBAvailabilityZoneProfileV2Basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBAvailabilityZoneProfileV2Exists("openstack_lb_availability_zone_profile_v2.azp_1", &azp),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availability_zone_profile_v2.azp_1", "name", "azp_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availability_zone_profile_v2.azp_1", "provider_name", "amphora"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availability_zone_profile_v2.azp_1", "availability_zone_data", `{"compute_zone":"nova"}`),
+				),
+			},
+			{
+				Config: testAccLBAvailabilityZoneProfileV2Update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBAvailabilityZoneProfileV2Exists("openstack_lb_availability_zone_profile_v2.azp_1", &azp),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availability_zone_profile_v2.azp_1", "name", "azp_1_updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availability_zone_profile_v2.azp_1", "availability_zone_data", `{"compute_zone":"nova"}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBAvailabilityZoneProfileV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	lbClient, err := config.LoadBalancerV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack load balancing client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_lb_availability_zone_profile_v2" {
+			continue
+		}
+
+		_, err := lbAvailabilityZoneProfileV2Get(lbClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Availability zone profile still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBAvailabilityZoneProfileV2Exists(n string, azp *lbAvailabilityZoneProfileV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		lbClient, err := config.LoadBalancerV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack load balancing client: %s", err)
+		}
+
+		found, err := lbAvailabilityZoneProfileV2Get(lbClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Availability zone profile not found")
+		}
+
+		*azp = *found
+
+		return nil
+	}
+}
+
+const testAccLBAvailabilityZoneProfileV2Basic = `
+resource "openstack_lb_availability_zone_profile_v2" "azp_1" {
+  name                   = "azp_1"
+  provider_name          = "amphora"
+  availability_zone_data = jsonencode({
+    compute_zone = "nova"
+  })
+}
+`
+
+const testAccLBAvailabilityZoneProfileV2Update = `
+resource "openstack_lb_availability_zone_profile_v2" "azp_1" {
+  name                   = "azp_1_updated"
+  provider_name          = "amphora"
+  availability_zone_data = jsonencode({
+    compute_zone = "nova"
+  })
+}
+`
@@ -0,0 +1,158 @@
+package vopencloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceLBAvailabilityZoneV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLBAvailabilityZoneV2Create,
+		ReadContext:   resourceLBAvailabilityZoneV2Read,
+		UpdateContext: resourceLBAvailabilityZoneV2Update,
+		DeleteContext: resourceLBAvailabilityZoneV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"availability_zone_profile_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceLBAvailabilityZoneV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error creating openstack_lb_availability_zone_v2: Only available when using octavia")
+	}
+
+	enabled := d.Get("enabled").(bool)
+	createOpts := lbAvailabilityZoneV2CreateOpts{
+		Name:                      d.Get("name").(string),
+		Description:               d.Get("description").(string),
+		AvailabilityZoneProfileID: d.Get("availability_zone_profile_id").(string),
+		Enabled:                   &enabled,
+	}
+
+	log.Printf("[DEBUG] openstack_lb_availability_zone_v2 create options: %#v", createOpts)
+
+	az, err := lbAvailabilityZoneV2Create(lbClient, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_lb_availability_zone_v2: %s", err)
+	}
+
+	d.SetId(az.Name)
+
+	return resourceLBAvailabilityZoneV2Read(ctx, d, meta)
+}
+
+func resourceLBAvailabilityZoneV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	az, err := lbAvailabilityZoneV2Get(lbClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_lb_availability_zone_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_availability_zone_v2 %s: %#v", d.Id(), az)
+
+	d.Set("name", az.Name)
+	d.Set("description", az.Description)
+	d.Set("availability_zone_profile_id", az.AvailabilityZoneProfileID)
+	d.Set("enabled", az.Enabled)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceLBAvailabilityZoneV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	var (
+		hasChange  bool
+		updateOpts lbAvailabilityZoneV2UpdateOpts
+	)
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("enabled") {
+		hasChange = true
+		enabled := d.Get("enabled").(bool)
+		updateOpts.Enabled = &enabled
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_lb_availability_zone_v2 %s update options: %#v", d.Id(), updateOpts)
+
+		err = lbAvailabilityZoneV2Update(lbClient, d.Id(), updateOpts)
+		if err != nil {
+			return diag.Errorf("Error updating openstack_lb_availability_zone_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceLBAvailabilityZoneV2Read(ctx, d, meta)
+}
+
+func resourceLBAvailabilityZoneV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	err = lbAvailabilityZoneV2Delete(lbClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_lb_availability_zone_v2"))
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccLBAvailabilityZoneV2_basic(t *testing.T) {
+	var az lbAvailabilityZoneV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBAvailabilityZoneV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBAvailabilityZoneV2Basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBAvailabilityZoneV2Exists("openstack_lb_availability_zone_v2.az_1", &az),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availability_zone_v2.az_1", "name", "az_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availability_zone_v2.az_1", "enabled", "true"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_lb_availability_zone_v2.az_1", "availability_zone_profile_id",
+						"openstack_lb_availability_zone_profile_v2.azp_1", "id"),
+				),
+			},
+			{
+				Config: testAccLBAvailabilityZoneV2Update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBAvailabilityZoneV2Exists("openstack_lb_availability_zone_v2.az_1", &az),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availability_zone_v2.az_1", "description", "nova zone"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availability_zone_v2.az_1", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBAvailabilityZoneV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	lbClient, err := config.LoadBalancerV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack load balancing client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_lb_availability_zone_v2" {
+			continue
+		}
+
+		_, err := lbAvailabilityZoneV2Get(lbClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Availability zone still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBAvailabilityZoneV2Exists(n string, az *lbAvailabilityZoneV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		lbClient, err := config.LoadBalancerV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack load balancing client: %s", err)
+		}
+
+		found, err := lbAvailabilityZoneV2Get(lbClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.Name != rs.Primary.ID {
+			return fmt.Errorf("Availability zone not found")
+		}
+
+		*az = *found
+
+		return nil
+	}
+}
+
+const testAccLBAvailabilityZoneV2Basic = `
+resource "openstack_lb_availability_zone_profile_v2" "azp_1" {
+  name                   = "azp_1"
+  provider_name          = "amphora"
+  availability_zone_data = jsonencode({
+    compute_zone = "nova"
+  })
+}
+
+resource "openstack_lb_availability_zone_v2" "az_1" {
+  name                         = "az_1"
+  availability_zone_profile_id = openstack_lb_availability_zone_profile_v2.azp_1.id
+}
+`
+
+const testAccLBAvailabilityZoneV2Update = `
+resource "openstack_lb_availability_zone_profile_v2" "azp_1" {
+  name                   = "azp_1"
+  provider_name          = "amphora"
+  availability_zone_data = jsonencode({
+    compute_zone = "nova"
+  })
+}
+
+resource "openstack_lb_availability_zone_v2" "az_1" {
+  name                         = "az_1"
+  description                  = "nova zone"
+  availability_zone_profile_id = openstack_lb_availability_zone_profile_v2.azp_1.id
+  enabled                      = false
+}
+`
@@ -0,0 +1,136 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccLBFlavorV2_basic(t *testing.T) {
+	var flavor lbFlavorV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBFlavorV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBFlavorV2Basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBFlavorV2Exists("openstack_lb_flavor_v2.flavor_1", &flavor),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_flavor_v2.flavor_1", "name", "flavor_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_flavor_v2.flavor_1", "enabled", "true"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_lb_flavor_v2.flavor_1", "flavor_profile_id",
+						"openstack_lb_flavorprofile_v2.fp_1", "id"),
+				),
+			},
+			{
+				Config: testAccLBFlavorV2Update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBFlavorV2Exists("openstack_lb_flavor_v2.flavor_1", &flavor),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_flavor_v2.flavor_1", "name", "flavor_1_updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_flavor_v2.flavor_1", "description", "single topology"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_flavor_v2.flavor_1", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBFlavorV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	lbClient, err := config.LoadBalancerV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack load balancing client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_lb_flavor_v2" {
+			continue
+		}
+
+		_, err := lbFlavorV2Get(lbClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Flavor still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBFlavorV2Exists(n string, flavor *lbFlavorV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		lbClient, err := config.LoadBalancerV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack load balancing client: %s", err)
+		}
+
+		found, err := lbFlavorV2Get(lbClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Flavor not found")
+		}
+
+		*flavor = *found
+
+		return nil
+	}
+}
+
+const testAccLBFlavorV2Basic = `
+resource "openstack_lb_flavorprofile_v2" "fp_1" {
+  name          = "fp_1"
+  provider_name = "amphora"
+  flavor_data   = jsonencode({
+    loadbalancer_topology = "SINGLE"
+  })
+}
+
+resource "openstack_lb_flavor_v2" "flavor_1" {
+  name              = "flavor_1"
+  flavor_profile_id = openstack_lb_flavorprofile_v2.fp_1.id
+}
+`
+
+const testAccLBFlavorV2Update = `
+resource "openstack_lb_flavorprofile_v2" "fp_1" {
+  name          = "fp_1"
+  provider_name = "amphora"
+  flavor_data   = jsonencode({
+    loadbalancer_topology = "SINGLE"
+  })
+}
+
+resource "openstack_lb_flavor_v2" "flavor_1" {
+  name              = "flavor_1_updated"
+  description       = "single topology"
+  flavor_profile_id = openstack_lb_flavorprofile_v2.fp_1.id
+  enabled           = false
+}
+`
@@ -0,0 +1,160 @@
+package vopencloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+func resourceLBFlavorProfileV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLBFlavorProfileV2Create,
+		ReadContext:   resourceLBFlavorProfileV2Read,
+		UpdateContext: resourceLBFlavorProfileV2Update,
+		DeleteContext: resourceLBFlavorProfileV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"provider_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"flavor_data": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: diffSuppressJSONObject,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceLBFlavorProfileV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	if lbClient.Type != octaviaLBClientType {
+		return diag.Errorf("Error creating openstack_lb_flavorprofile_v2: Only available when using octavia")
+	}
+
+	createOpts := lbFlavorProfileV2CreateOpts{
+		Name:         d.Get("name").(string),
+		ProviderName: d.Get("provider_name").(string),
+		FlavorData:   d.Get("flavor_data").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_lb_flavorprofile_v2 create options: %#v", createOpts)
+
+	fp, err := lbFlavorProfileV2Create(lbClient, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_lb_flavorprofile_v2: %s", err)
+	}
+
+	d.SetId(fp.ID)
+
+	return resourceLBFlavorProfileV2Read(ctx, d, meta)
+}
+
+func resourceLBFlavorProfileV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	fp, err := lbFlavorProfileV2Get(lbClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_lb_flavorprofile_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_flavorprofile_v2 %s: %#v", d.Id(), fp)
+
+	d.Set("name", fp.Name)
+	d.Set("provider_name", fp.ProviderName)
+	d.Set("flavor_data", normalizeLBV2JSONData(fp.FlavorData))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceLBFlavorProfileV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	var (
+		hasChange  bool
+		updateOpts lbFlavorProfileV2UpdateOpts
+	)
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("provider_name") {
+		hasChange = true
+		providerName := d.Get("provider_name").(string)
+		updateOpts.ProviderName = &providerName
+	}
+
+	if d.HasChange("flavor_data") {
+		hasChange = true
+		flavorData := d.Get("flavor_data").(string)
+		updateOpts.FlavorData = &flavorData
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_lb_flavorprofile_v2 %s update options: %#v", d.Id(), updateOpts)
+
+		err = lbFlavorProfileV2Update(lbClient, d.Id(), updateOpts)
+		if err != nil {
+			return diag.Errorf("Error updating openstack_lb_flavorprofile_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceLBFlavorProfileV2Read(ctx, d, meta)
+}
+
+func resourceLBFlavorProfileV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	err = lbFlavorProfileV2Delete(lbClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_lb_flavorprofile_v2"))
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package vopencloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccLBFlavorProfileV2_basic(t *testing.T) {
+	var fp lbFlavorProfileV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBFlavorProfileV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBFlavorProfileV2Basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBFlavorProfileV2Exists("openstack_lb_flavorprofile_v2.fp_1", &fp),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_flavorprofile_v2.fp_1", "name", "fp_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_flavorprofile_v2.fp_1", "provider_name", "amphora"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_flavorprofile_v2.fp_1", "flavor_data", `{"loadbalancer_topology":"SINGLE"}`),
+				),
+			},
+			{
+				Config: testAccLBFlavorProfileV2Update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBFlavorProfileV2Exists("openstack_lb_flavorprofile_v2.fp_1", &fp),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_flavorprofile_v2.fp_1", "name", "fp_1_updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_flavorprofile_v2.fp_1", "flavor_data", `{"loadbalancer_topology":"ACTIVE_STANDBY"}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBFlavorProfileV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	lbClient, err := config.LoadBalancerV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack load balancing client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_lb_flavorprofile_v2" {
+			continue
+		}
+
+		_, err := lbFlavorProfileV2Get(lbClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Flavor profile still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBFlavorProfileV2Exists(n string, fp *lbFlavorProfileV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		lbClient, err := config.LoadBalancerV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack load balancing client: %s", err)
+		}
+
+		found, err := lbFlavorProfileV2Get(lbClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Flavor profile not found")
+		}
+
+		*fp = *found
+
+		return nil
+	}
+}
+
+const testAccLBFlavorProfileV2Basic = `
+resource "openstack_lb_flavorprofile_v2" "fp_1" {
+  name          = "fp_1"
+  provider_name = "amphora"
+  flavor_data   = jsonencode({
+    loadbalancer_topology = "SINGLE"
+  })
+}
+`
+
+const testAccLBFlavorProfileV2Update = `
+resource "openstack_lb_flavorprofile_v2" "fp_1" {
+  name          = "fp_1_updated"
+  provider_name = "amphora"
+  flavor_data   = jsonencode({
+    loadbalancer_topology = "ACTIVE_STANDBY"
+  })
+}
+`
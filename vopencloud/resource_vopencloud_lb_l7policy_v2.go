@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	octavial7policies "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/l7policies"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/l7policies"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/listeners"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
@@ -33,6 +35,8 @@ func resourceL7PolicyV2() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: resourceL7PolicyV2CustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -62,7 +66,7 @@ func resourceL7PolicyV2() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					"REDIRECT_TO_POOL", "REDIRECT_TO_URL", "REJECT",
+					"REDIRECT_TO_POOL", "REDIRECT_TO_URL", "REDIRECT_PREFIX", "REJECT",
 				}, true),
 			},
 
@@ -80,22 +84,29 @@ func resourceL7PolicyV2() *schema.Resource {
 
 			"redirect_pool_id": {
 				Type:          schema.TypeString,
-				ConflictsWith: []string{"redirect_url"},
+				ConflictsWith: []string{"redirect_url", "redirect_prefix"},
 				Optional:      true,
 			},
 
 			"redirect_url": {
 				Type:          schema.TypeString,
-				ConflictsWith: []string{"redirect_pool_id"},
+				ConflictsWith: []string{"redirect_pool_id", "redirect_prefix"},
 				Optional:      true,
-				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
-					value := v.(string)
-					_, err := url.ParseRequestURI(value)
-					if err != nil {
-						errors = append(errors, fmt.Errorf("URL is not valid: %s", err))
-					}
-					return
-				},
+				ValidateFunc:  validateL7PolicyRedirectURL,
+			},
+
+			"redirect_prefix": {
+				Type:          schema.TypeString,
+				ConflictsWith: []string{"redirect_pool_id", "redirect_url"},
+				Optional:      true,
+				ValidateFunc:  validateL7PolicyRedirectURL,
+			},
+
+			"redirect_http_code": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntInSlice([]int{301, 302, 303, 307, 308}),
 			},
 
 			"admin_state_up": {
@@ -119,28 +130,24 @@ func resourceL7PolicyV2Create(ctx context.Context, d *schema.ResourceData, meta
 	action := d.Get("action").(string)
 	redirectPoolID := d.Get("redirect_pool_id").(string)
 	redirectURL := d.Get("redirect_url").(string)
+	redirectPrefix := d.Get("redirect_prefix").(string)
+	redirectHTTPCode := d.Get("redirect_http_code").(int)
 
 	// Ensure the right combination of options have been specified.
-	err = checkL7PolicyAction(action, redirectURL, redirectPoolID)
+	err = checkL7PolicyAction(action, redirectURL, redirectPoolID, redirectPrefix, redirectHTTPCode)
 	if err != nil {
 		return diag.Errorf("Unable to create L7 Policy: %s", err)
 	}
 
-	adminStateUp := d.Get("admin_state_up").(bool)
-	createOpts := l7policies.CreateOpts{
-		TenantID:       d.Get("tenant_id").(string),
-		Name:           d.Get("name").(string),
-		Description:    d.Get("description").(string),
-		Action:         l7policies.Action(action),
-		ListenerID:     listenerID,
-		RedirectPoolID: redirectPoolID,
-		RedirectURL:    redirectURL,
-		AdminStateUp:   &adminStateUp,
+	if config.UseOctavia {
+		args := lbV2ChangedAPIVersionArguments(d, lbV2L7PolicyAPIVersions)
+		if err := checkLBV2APIVersion(lbClient, args, lbV2L7PolicyAPIVersions); err != nil {
+			return diag.Errorf("Unable to create L7 Policy: %s", err)
+		}
 	}
 
-	if v, ok := d.GetOk("position"); ok {
-		createOpts.Position = int32(v.(int))
-	}
+	// Choose either the Octavia or Neutron create options.
+	createOpts := chooseLBV2L7PolicyCreateOpts(d, config)
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 
@@ -203,6 +210,31 @@ func resourceL7PolicyV2Read(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
+	// Use Octavia L7 Policy body if Octavia/LBaaS is enabled.
+	if config.UseOctavia {
+		l7Policy, err := octavial7policies.Get(lbClient, d.Id()).Extract()
+		if err != nil {
+			return diag.FromErr(CheckDeleted(d, err, "L7 Policy"))
+		}
+
+		log.Printf("[DEBUG] Retrieved L7 Policy %s: %#v", d.Id(), l7Policy)
+
+		d.Set("action", l7Policy.Action)
+		d.Set("description", l7Policy.Description)
+		d.Set("tenant_id", l7Policy.ProjectID)
+		d.Set("name", l7Policy.Name)
+		d.Set("position", int(l7Policy.Position))
+		d.Set("redirect_url", l7Policy.RedirectURL)
+		d.Set("redirect_pool_id", l7Policy.RedirectPoolID)
+		d.Set("redirect_prefix", l7Policy.RedirectPrefix)
+		d.Set("redirect_http_code", int(l7Policy.RedirectHttpCode))
+		d.Set("region", GetRegion(d, config))
+		d.Set("admin_state_up", l7Policy.AdminStateUp)
+
+		return nil
+	}
+
+	// Use Neutron/Networking in other case.
 	l7Policy, err := l7policies.Get(lbClient, d.Id()).Extract()
 	if err != nil {
 		return diag.FromErr(CheckDeleted(d, err, "L7 Policy"))
@@ -235,43 +267,25 @@ func resourceL7PolicyV2Update(ctx context.Context, d *schema.ResourceData, meta
 	action := d.Get("action").(string)
 	redirectPoolID := d.Get("redirect_pool_id").(string)
 	redirectURL := d.Get("redirect_url").(string)
-
-	var updateOpts l7policies.UpdateOpts
-
-	if d.HasChange("action") {
-		updateOpts.Action = l7policies.Action(action)
-	}
-	if d.HasChange("name") {
-		name := d.Get("name").(string)
-		updateOpts.Name = &name
-	}
-	if d.HasChange("description") {
-		description := d.Get("description").(string)
-		updateOpts.Description = &description
-	}
-	if d.HasChange("redirect_pool_id") {
-		redirectPoolID = d.Get("redirect_pool_id").(string)
-
-		updateOpts.RedirectPoolID = &redirectPoolID
-	}
-	if d.HasChange("redirect_url") {
-		redirectURL = d.Get("redirect_url").(string)
-		updateOpts.RedirectURL = &redirectURL
-	}
-	if d.HasChange("position") {
-		updateOpts.Position = int32(d.Get("position").(int))
-	}
-	if d.HasChange("admin_state_up") {
-		adminStateUp := d.Get("admin_state_up").(bool)
-		updateOpts.AdminStateUp = &adminStateUp
-	}
+	redirectPrefix := d.Get("redirect_prefix").(string)
+	redirectHTTPCode := d.Get("redirect_http_code").(int)
 
 	// Ensure the right combination of options have been specified.
-	err = checkL7PolicyAction(action, redirectURL, redirectPoolID)
+	err = checkL7PolicyAction(action, redirectURL, redirectPoolID, redirectPrefix, redirectHTTPCode)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if config.UseOctavia {
+		args := lbV2ChangedAPIVersionArguments(d, lbV2L7PolicyAPIVersions)
+		if err := checkLBV2APIVersion(lbClient, args, lbV2L7PolicyAPIVersions); err != nil {
+			return diag.Errorf("Unable to update L7 Policy %s: %s", d.Id(), err)
+		}
+	}
+
+	// Choose either the Octavia or Neutron update options.
+	updateOpts := chooseLBV2L7PolicyUpdateOpts(d, config)
+
 	// Make sure the pool is active before continuing.
 	timeout := d.Timeout(schema.TimeoutUpdate)
 	if redirectPoolID != "" {
@@ -409,20 +423,80 @@ func resourceL7PolicyV2Import(d *schema.ResourceData, meta interface{}) ([]*sche
 	return []*schema.ResourceData{d}, nil
 }
 
-func checkL7PolicyAction(action, redirectURL, redirectPoolID string) error {
+// resourceL7PolicyV2CustomizeDiff validates the redirect arguments against the
+// action at plan time. The computed redirect_http_code is reset, when the
+// action doesn't support it and the code is not set explicitly.
+func resourceL7PolicyV2CustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	action := strings.ToUpper(diff.Get("action").(string))
+
+	raw := diff.GetRawConfig()
+	if !raw.IsNull() && raw.GetAttr("redirect_http_code").IsNull() {
+		switch {
+		case action != "REDIRECT_TO_URL" && action != "REDIRECT_PREFIX":
+			if o, _ := diff.GetChange("redirect_http_code"); o.(int) != 0 {
+				if err := diff.SetNew("redirect_http_code", 0); err != nil {
+					return err
+				}
+			}
+		case diff.HasChange("action"):
+			if err := diff.SetNewComputed("redirect_http_code"); err != nil {
+				return err
+			}
+		}
+	}
+
+	redirectPrefix := diff.Get("redirect_prefix").(string)
+	redirectHTTPCode := diff.Get("redirect_http_code").(int)
+
+	config := meta.(*Config)
+	if !config.UseOctavia && (action == "REDIRECT_PREFIX" || redirectPrefix != "" || redirectHTTPCode != 0) {
+		return fmt.Errorf("REDIRECT_PREFIX action, redirect_prefix and redirect_http_code are only available when using octavia")
+	}
+
+	// Skip the validation, when the redirect arguments are not known yet.
+	for _, k := range []string{"redirect_url", "redirect_pool_id", "redirect_prefix"} {
+		if !diff.NewValueKnown(k) {
+			return nil
+		}
+	}
+
+	return checkL7PolicyAction(action, diff.Get("redirect_url").(string),
+		diff.Get("redirect_pool_id").(string), redirectPrefix, redirectHTTPCode)
+}
+
+func validateL7PolicyRedirectURL(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	_, err := url.ParseRequestURI(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("URL is not valid: %s", err))
+	}
+	return
+}
+
+func checkL7PolicyAction(action, redirectURL, redirectPoolID, redirectPrefix string, redirectHTTPCode int) error {
+	action = strings.ToUpper(action)
+
 	if action == "REJECT" {
-		if redirectURL != "" || redirectPoolID != "" {
+		if redirectURL != "" || redirectPoolID != "" || redirectPrefix != "" {
 			return fmt.Errorf(
-				"redirect_url and redirect_pool_id must be empty when action is set to %s", action)
+				"redirect_url, redirect_pool_id and redirect_prefix must be empty when action is set to %s", action)
 		}
 	}
 
-	if action == "REDIRECT_TO_POOL" && redirectURL != "" {
-		return fmt.Errorf("redirect_url must be empty when action is set to %s", action)
+	if action == "REDIRECT_TO_POOL" && (redirectURL != "" || redirectPrefix != "") {
+		return fmt.Errorf("redirect_url and redirect_prefix must be empty when action is set to %s", action)
+	}
+
+	if action == "REDIRECT_TO_URL" && (redirectPoolID != "" || redirectPrefix != "") {
+		return fmt.Errorf("redirect_pool_id and redirect_prefix must be empty when action is set to %s", action)
+	}
+
+	if action == "REDIRECT_PREFIX" && (redirectPoolID != "" || redirectURL != "") {
+		return fmt.Errorf("redirect_pool_id and redirect_url must be empty when action is set to %s", action)
 	}
 
-	if action == "REDIRECT_TO_URL" && redirectPoolID != "" {
-		return fmt.Errorf("redirect_pool_id must be empty when action is set to %s", action)
+	if redirectHTTPCode != 0 && action != "REDIRECT_TO_URL" && action != "REDIRECT_PREFIX" {
+		return fmt.Errorf("redirect_http_code can only be set when action is set to REDIRECT_TO_URL or REDIRECT_PREFIX")
 	}
 
 	return nil
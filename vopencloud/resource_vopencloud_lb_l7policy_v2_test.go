@@ -100,6 +100,59 @@ func TestAccLBV2L7Policy_basic(t *testing.T) {
 	})
 }
 
+func TestAccLBV2L7Policy_redirectPrefix(t *testing.T) {
+	var l7Policy l7policies.L7Policy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2L7PolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLbV2L7PolicyConfigRedirectPrefix(301),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7PolicyExists("openstack_lb_l7policy_v2.l7policy_1", &l7Policy),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "action", "REDIRECT_PREFIX"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "redirect_prefix", "https://www.example.com"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "redirect_http_code", "301"),
+				),
+			},
+			{
+				Config: testAccCheckLbV2L7PolicyConfigRedirectPrefix(308),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7PolicyExists("openstack_lb_l7policy_v2.l7policy_1", &l7Policy),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "redirect_http_code", "308"),
+				),
+			},
+			{
+				Config: testAccCheckLbV2L7PolicyConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7PolicyExists("openstack_lb_l7policy_v2.l7policy_1", &l7Policy),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "action", "REJECT"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "redirect_prefix", ""),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "redirect_http_code", "0"),
+				),
+			},
+			{
+				Config:      testAccCheckLbV2L7PolicyConfigInvalidHTTPCode,
+				ExpectError: regexp.MustCompile("redirect_http_code can only be set when action is set to REDIRECT_TO_URL or REDIRECT_PREFIX"),
+			},
+		},
+	})
+}
+
 func testAccCheckLBV2L7PolicyDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	lbClient, err := chooseLBV2AccTestClient(config, osRegionName)
@@ -254,3 +307,30 @@ resource "openstack_lb_l7policy_v2" "l7policy_1" {
 }
 `, testAccCheckLbV2L7PolicyConfig)
 }
+
+func testAccCheckLbV2L7PolicyConfigRedirectPrefix(httpCode int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_lb_l7policy_v2" "l7policy_1" {
+  name               = "test"
+  action             = "REDIRECT_PREFIX"
+  position           = 1
+  listener_id        = "${openstack_lb_listener_v2.listener_1.id}"
+  redirect_prefix    = "https://www.example.com"
+  redirect_http_code = %d
+}
+`, testAccCheckLbV2L7PolicyConfig, httpCode)
+}
+
+var testAccCheckLbV2L7PolicyConfigInvalidHTTPCode = fmt.Sprintf(`
+%s
+
+resource "openstack_lb_l7policy_v2" "l7policy_1" {
+  name               = "test"
+  action             = "REJECT"
+  position           = 1
+  listener_id        = "${openstack_lb_listener_v2.listener_1.id}"
+  redirect_http_code = 301
+}
+`, testAccCheckLbV2L7PolicyConfig)
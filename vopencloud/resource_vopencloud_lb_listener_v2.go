@@ -30,6 +30,8 @@ func resourceListenerV2() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: customizeDiffLBV2DefaultTags,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -142,12 +144,93 @@ func resourceListenerV2() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"tls_ciphers": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tls_versions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"SSLv3", "TLSv1", "TLSv1.1", "TLSv1.2", "TLSv1.3",
+					}, false),
+				},
+			},
+
+			"alpn_protocols": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"http/1.0", "http/1.1", "h2",
+					}, false),
+				},
+			},
+
+			"client_authentication": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"NONE", "OPTIONAL", "MANDATORY",
+				}, false),
+			},
+
+			"client_ca_tls_container_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"client_crl_container_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"hsts_max_age": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"hsts_include_subdomains": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				RequiredWith: []string{"hsts_max_age"},
+			},
+
+			"hsts_preload": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				RequiredWith: []string{"hsts_max_age"},
+			},
+
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+
+			"all_tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"default_tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 		},
 	}
 }
@@ -167,6 +250,18 @@ func resourceListenerV2Create(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	if config.UseOctavia {
+		args := lbV2ChangedAPIVersionArguments(d, lbV2ListenerAPIVersions)
+		if err := checkLBV2APIVersion(lbClient, args, lbV2ListenerAPIVersions); err != nil {
+			return diag.Errorf("Error creating openstack_lb_listener_v2: %s", err)
+		}
+	} else {
+		args := lbV2ChangedAPIVersionArguments(d, lbV2ListenerAPIVersions)
+		if err := checkLBV2OctaviaArguments(args); err != nil {
+			return diag.Errorf("Error creating openstack_lb_listener_v2: %s", err)
+		}
+	}
+
 	// Choose either the Octavia or Neutron create options.
 	createOpts, err := chooseLBV2ListenerCreateOpts(d, config)
 	if err != nil {
@@ -207,11 +302,17 @@ func resourceListenerV2Read(ctx context.Context, d *schema.ResourceData, meta in
 
 	// Use Octavia listener body if Octavia/LBaaS is enabled.
 	if config.UseOctavia {
-		listener, err := octavialisteners.Get(lbClient, d.Id()).Extract()
+		r := octavialisteners.Get(lbClient, d.Id())
+		listener, err := r.Extract()
 		if err != nil {
 			return diag.FromErr(CheckDeleted(d, err, "openstack_lb_listener_v2"))
 		}
 
+		var hsts lbV2ListenerHSTS
+		if err := r.ExtractIntoStructPtr(&hsts, "listener"); err != nil {
+			return diag.Errorf("Unable to extract openstack_lb_listener_v2 %s HSTS settings: %s", d.Id(), err)
+		}
+
 		log.Printf("[DEBUG] Retrieved openstack_lb_listener_v2 %s: %#v", d.Id(), listener)
 
 		d.Set("name", listener.Name)
@@ -229,8 +330,22 @@ func resourceListenerV2Read(ctx context.Context, d *schema.ResourceData, meta in
 		d.Set("sni_container_refs", listener.SniContainerRefs)
 		d.Set("default_tls_container_ref", listener.DefaultTlsContainerRef)
 		d.Set("allowed_cidrs", listener.AllowedCIDRs)
+		d.Set("tls_ciphers", listener.TLSCiphers)
+		d.Set("tls_versions", listener.TLSVersions)
+		d.Set("alpn_protocols", listener.ALPNProtocols)
+		d.Set("client_authentication", listener.ClientAuthentication)
+		d.Set("client_ca_tls_container_ref", listener.ClientCATLSContainerRef)
+		d.Set("client_crl_container_ref", listener.ClientCRLContainerRef)
+		d.Set("hsts_include_subdomains", hsts.IncludeSubdomains)
+		d.Set("hsts_preload", hsts.Preload)
 		d.Set("region", GetRegion(d, config))
-		d.Set("tags", listener.Tags)
+
+		if hsts.MaxAge != nil {
+			d.Set("hsts_max_age", *hsts.MaxAge)
+		} else {
+			d.Set("hsts_max_age", 0)
+		}
+		expandObjectReadTagsWithImport(d, listener.Tags, config.DefaultTags)
 
 		// Required by import.
 		if len(listener.Loadbalancers) > 0 {
@@ -292,6 +407,18 @@ func resourceListenerV2Update(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	if config.UseOctavia {
+		args := lbV2ChangedAPIVersionArguments(d, lbV2ListenerAPIVersions)
+		if err := checkLBV2APIVersion(lbClient, args, lbV2ListenerAPIVersions); err != nil {
+			return diag.Errorf("Error updating openstack_lb_listener_v2 %s: %s", d.Id(), err)
+		}
+	} else {
+		args := lbV2ChangedAPIVersionArguments(d, lbV2ListenerAPIVersions)
+		if err := checkLBV2OctaviaArguments(args); err != nil {
+			return diag.Errorf("Error updating openstack_lb_listener_v2 %s: %s", d.Id(), err)
+		}
+	}
+
 	updateOpts, err := chooseLBV2ListenerUpdateOpts(d, config)
 	if err != nil {
 		return diag.Errorf("Error building openstack_lb_listener_v2 update options: %s", err)
@@ -29,6 +29,8 @@ func resourceLoadBalancerV2() *schema.Resource {
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
+		CustomizeDiff: customizeDiffLBV2DefaultTags,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -82,6 +84,32 @@ func resourceLoadBalancerV2() *schema.Resource {
 				Computed: true,
 			},
 
+			"additional_vips": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"vip_qos_policy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"admin_state_up": {
 				Type:     schema.TypeBool,
 				Default:  true,
@@ -121,6 +149,26 @@ func resourceLoadBalancerV2() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+
+			"all_tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"default_tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"failover_triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -146,16 +194,17 @@ func resourceLoadBalancerV2Create(ctx context.Context, d *schema.ResourceData, m
 
 	if lbClient.Type == octaviaLBClientType {
 		createOpts := octavialoadbalancers.CreateOpts{
-			Name:         d.Get("name").(string),
-			Description:  d.Get("description").(string),
-			VipNetworkID: d.Get("vip_network_id").(string),
-			VipSubnetID:  d.Get("vip_subnet_id").(string),
-			VipPortID:    d.Get("vip_port_id").(string),
-			ProjectID:    d.Get("tenant_id").(string),
-			VipAddress:   d.Get("vip_address").(string),
-			AdminStateUp: &adminStateUp,
-			FlavorID:     d.Get("flavor_id").(string),
-			Provider:     lbProvider,
+			Name:           d.Get("name").(string),
+			Description:    d.Get("description").(string),
+			VipNetworkID:   d.Get("vip_network_id").(string),
+			VipSubnetID:    d.Get("vip_subnet_id").(string),
+			VipPortID:      d.Get("vip_port_id").(string),
+			ProjectID:      d.Get("tenant_id").(string),
+			VipAddress:     d.Get("vip_address").(string),
+			AdminStateUp:   &adminStateUp,
+			FlavorID:       d.Get("flavor_id").(string),
+			Provider:       lbProvider,
+			VipQosPolicyID: d.Get("vip_qos_policy_id").(string),
 		}
 
 		// availability_zone requires octavia minor version 2.14. Only set when specified.
@@ -164,13 +213,22 @@ func resourceLoadBalancerV2Create(ctx context.Context, d *schema.ResourceData, m
 			createOpts.AvailabilityZone = aZ
 		}
 
-		if v, ok := d.GetOk("tags"); ok {
-			tags := v.(*schema.Set).List()
-			createOpts.Tags = expandToStringSlice(tags)
+		if tags := expandObjectCreateTags(d, config.DefaultTags); len(tags) > 0 {
+			createOpts.Tags = tags
 		}
 
-		log.Printf("[DEBUG][Octavia] openstack_lb_loadbalancer_v2 create options: %#v", createOpts)
-		lb, err := octavialoadbalancers.Create(lbClient, createOpts).Extract()
+		args := lbV2ChangedAPIVersionArguments(d, lbV2LoadBalancerAPIVersions)
+		if err := checkLBV2APIVersion(lbClient, args, lbV2LoadBalancerAPIVersions); err != nil {
+			return diag.Errorf("Error creating openstack_lb_loadbalancer_v2: %s", err)
+		}
+
+		opts := LoadBalancerCreateOpts{
+			CreateOpts:     createOpts,
+			AdditionalVips: expandLBV2AdditionalVips(d.Get("additional_vips").([]interface{})),
+		}
+
+		log.Printf("[DEBUG][Octavia] openstack_lb_loadbalancer_v2 create options: %#v", opts)
+		lb, err := octavialoadbalancers.Create(lbClient, opts).Extract()
 		if err != nil {
 			return diag.Errorf("Error creating openstack_lb_loadbalancer_v2: %s", err)
 		}
@@ -229,13 +287,19 @@ func resourceLoadBalancerV2Read(ctx context.Context, d *schema.ResourceData, met
 	var vipPortID string
 
 	if lbClient.Type == octaviaLBClientType {
-		lb, err := octavialoadbalancers.Get(lbClient, d.Id()).Extract()
+		r := octavialoadbalancers.Get(lbClient, d.Id())
+		lb, err := r.Extract()
 		if err != nil {
 			return diag.FromErr(CheckDeleted(d, err, "Unable to retrieve openstack_lb_loadbalancer_v2"))
 		}
 
 		log.Printf("[DEBUG][Octavia] Retrieved openstack_lb_loadbalancer_v2 %s: %#v", d.Id(), lb)
 
+		var vips lbV2LoadBalancerAdditionalVips
+		if err := r.ExtractIntoStructPtr(&vips, "loadbalancer"); err != nil {
+			return diag.Errorf("Unable to extract openstack_lb_loadbalancer_v2 %s additional VIPs: %s", d.Id(), err)
+		}
+
 		d.Set("name", lb.Name)
 		d.Set("description", lb.Description)
 		d.Set("vip_subnet_id", lb.VipSubnetID)
@@ -247,8 +311,10 @@ func resourceLoadBalancerV2Read(ctx context.Context, d *schema.ResourceData, met
 		d.Set("flavor_id", lb.FlavorID)
 		d.Set("loadbalancer_provider", lb.Provider)
 		d.Set("availability_zone", lb.AvailabilityZone)
+		d.Set("vip_qos_policy_id", lb.VipQosPolicyID)
+		d.Set("additional_vips", flattenLBV2AdditionalVips(vips.AdditionalVips))
 		d.Set("region", GetRegion(d, config))
-		d.Set("tags", lb.Tags)
+		expandObjectReadTagsWithImport(d, lb.Tags, config.DefaultTags)
 		vipPortID = lb.VipPortID
 	} else {
 		lb, err := neutronloadbalancers.Get(lbClient, d.Id()).Extract()
@@ -325,6 +391,38 @@ func resourceLoadBalancerV2Update(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	// A change of the failover triggers initiates a failover of the
+	// load-balancer amphorae.
+	if d.HasChange("failover_triggers") {
+		if lbClient.Type != octaviaLBClientType {
+			return diag.Errorf("Error updating openstack_lb_loadbalancer_v2 %s: failover_triggers is only available when using octavia", d.Id())
+		}
+
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		err = waitForLBV2LoadBalancer(ctx, lbClient, d.Id(), "ACTIVE", getLbPendingStatuses(), timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		log.Printf("[DEBUG] Failing over openstack_lb_loadbalancer_v2 %s", d.Id())
+		err = resource.Retry(timeout, func() *resource.RetryError {
+			err = octavialoadbalancers.Failover(lbClient, d.Id()).ExtractErr()
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+			return nil
+		})
+
+		if err != nil {
+			return diag.Errorf("Error failing over openstack_lb_loadbalancer_v2 %s: %s", d.Id(), err)
+		}
+
+		err = waitForLBV2LoadBalancer(ctx, lbClient, d.Id(), "ACTIVE", getLbPendingStatuses(), timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	// Security Groups get updated separately.
 	if d.HasChange("security_group_ids") {
 		networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
@@ -31,6 +31,8 @@ func resourceMembersV2() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: resourceMembersV2CustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -48,6 +50,7 @@ func resourceMembersV2() *schema.Resource {
 			"member": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -107,6 +110,69 @@ func resourceMembersV2() *schema.Resource {
 					},
 				},
 			},
+
+			"member_source": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"member"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"server_group_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							AtLeastOneOf: []string{"member_source.0.server_group_id", "member_source.0.metadata", "member_source.0.tags"},
+						},
+
+						"metadata": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"tags": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"protocol_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(0, 256),
+						},
+
+						"monitor_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+
+						"backup": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+
+						"admin_state_up": {
+							Type:     schema.TypeBool,
+							Default:  true,
+							Optional: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -118,7 +184,12 @@ func resourceMembersV2Create(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	createOpts := expandLBMembersV2(d.Get("member").(*schema.Set), lbClient)
+	members, err := resourceMembersV2Members(d, config)
+	if err != nil {
+		return diag.Errorf("Error resolving openstack_lb_members_v2 member_source: %s", err)
+	}
+
+	createOpts := expandLBMembersV2(members, lbClient)
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 
 	// Get a clean copy of the parent pool.
@@ -192,8 +263,13 @@ func resourceMembersV2Update(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	if d.HasChange("member") {
-		updateOpts := expandLBMembersV2(d.Get("member").(*schema.Set), lbClient)
+	if d.HasChanges("member", "member_source") {
+		members, err := resourceMembersV2Members(d, config)
+		if err != nil {
+			return diag.Errorf("Error resolving openstack_lb_members_v2 member_source: %s", err)
+		}
+
+		updateOpts := expandLBMembersV2(members, lbClient)
 
 		// Get a clean copy of the parent pool.
 		parentPool, err := neutronpools.Get(lbClient, d.Id()).Extract()
@@ -272,3 +348,21 @@ func resourceMembersV2Delete(ctx context.Context, d *schema.ResourceData, meta i
 
 	return nil
 }
+
+// resourceMembersV2Members returns the configured pool members. When
+// member_source is set, the instances are resolved again at apply time.
+func resourceMembersV2Members(d *schema.ResourceData, config *Config) (*schema.Set, error) {
+	members := d.Get("member").(*schema.Set)
+
+	source, ok := d.GetOk("member_source")
+	if !ok {
+		return members, nil
+	}
+
+	resolved, err := resolveLBMembersV2Source(config, GetRegion(d, config), source.([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewSet(members.F, resolved), nil
+}
@@ -101,6 +101,45 @@ func TestAccLBV2Members_basic(t *testing.T) {
 	})
 }
 
+func TestAccLBV2Members_memberSource(t *testing.T) {
+	var members []pools.Member
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2MembersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2MembersConfigMemberSource(2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2MembersExists("openstack_lb_members_v2.members_1", &members),
+					resource.TestCheckResourceAttr("openstack_lb_members_v2.members_1", "member.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("openstack_lb_members_v2.members_1", "member.*", map[string]string{
+						"name":          "instance_0",
+						"protocol_port": "8080",
+					}),
+				),
+			},
+			{
+				Config: testAccLbV2MembersConfigMemberSource(3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2MembersExists("openstack_lb_members_v2.members_1", &members),
+					resource.TestCheckResourceAttr("openstack_lb_members_v2.members_1", "member.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs("openstack_lb_members_v2.members_1", "member.*", map[string]string{
+						"name":          "instance_2",
+						"protocol_port": "8080",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLBV2MembersDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	lbClient, err := chooseLBV2AccTestClient(config, osRegionName)
@@ -396,3 +435,75 @@ resource "openstack_lb_members_v2" "members_1" {
   }
 }
 `
+
+func testAccLbV2MembersConfigMemberSource(count int) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+}
+
+resource "openstack_compute_servergroup_v2" "sg_1" {
+  name = "sg_1"
+  policies = ["soft-anti-affinity"]
+}
+
+resource "openstack_compute_instance_v2" "instance" {
+  count = %d
+  name = "instance_${count.index}"
+  security_groups = ["default"]
+  scheduler_hints {
+    group = "${openstack_compute_servergroup_v2.sg_1.id}"
+  }
+  network {
+    uuid = "${openstack_networking_network_v2.network_1.id}"
+  }
+  depends_on = ["openstack_networking_subnet_v2.subnet_1"]
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+  vip_address = "192.168.199.10"
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  listener_id = "${openstack_lb_listener_v2.listener_1.id}"
+}
+
+resource "openstack_lb_members_v2" "members_1" {
+  pool_id = "${openstack_lb_pool_v2.pool_1.id}"
+
+  member_source {
+    server_group_id = "${openstack_compute_servergroup_v2.sg_1.id}"
+    subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+    protocol_port = 8080
+  }
+
+  depends_on = ["openstack_compute_instance_v2.instance"]
+
+  timeouts {
+    create = "10m"
+    update = "10m"
+    delete = "10m"
+  }
+}
+`, count)
+}
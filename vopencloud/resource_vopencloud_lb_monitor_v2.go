@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -33,6 +35,11 @@ func resourceMonitorV2() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.All(
+			customizeDiffLBV2DefaultTags,
+			resourceMonitorV2CustomizeDiff,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -64,7 +71,7 @@ func resourceMonitorV2() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					"TCP", "UDP-CONNECT", "HTTP", "HTTPS", "TLS-HELLO", "PING",
+					"TCP", "UDP-CONNECT", "SCTP", "HTTP", "HTTPS", "TLS-HELLO", "PING",
 				}, false),
 			},
 
@@ -107,11 +114,50 @@ func resourceMonitorV2() *schema.Resource {
 				Computed: true,
 			},
 
+			"http_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"1.0", "1.1",
+				}, false),
+			},
+
+			"domain_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"admin_state_up": {
 				Type:     schema.TypeBool,
 				Default:  true,
 				Optional: true,
 			},
+
+			"operating_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"all_tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"default_tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 		},
 	}
 }
@@ -174,11 +220,17 @@ func resourceMonitorV2Read(ctx context.Context, d *schema.ResourceData, meta int
 
 	// Use Octavia monitor body if Octavia/LBaaS is enabled.
 	if config.UseOctavia {
-		monitor, err := octaviamonitors.Get(lbClient, d.Id()).Extract()
+		r := octaviamonitors.Get(lbClient, d.Id())
+		monitor, err := r.Extract()
 		if err != nil {
 			return diag.FromErr(CheckDeleted(d, err, "monitor"))
 		}
 
+		var details lbV2MonitorDetails
+		if err := r.ExtractIntoStructPtr(&details, "healthmonitor"); err != nil {
+			return diag.Errorf("Unable to extract openstack_lb_monitor_v2 %s details: %s", d.Id(), err)
+		}
+
 		log.Printf("[DEBUG] Retrieved openstack_lb_monitor_v2 %s: %#v", d.Id(), monitor)
 
 		d.Set("tenant_id", monitor.ProjectID)
@@ -190,10 +242,20 @@ func resourceMonitorV2Read(ctx context.Context, d *schema.ResourceData, meta int
 		d.Set("url_path", monitor.URLPath)
 		d.Set("http_method", monitor.HTTPMethod)
 		d.Set("expected_codes", monitor.ExpectedCodes)
+		d.Set("domain_name", details.DomainName)
 		d.Set("admin_state_up", monitor.AdminStateUp)
+		d.Set("operating_status", monitor.OperatingStatus)
 		d.Set("name", monitor.Name)
 		d.Set("region", GetRegion(d, config))
 
+		if details.HTTPVersion != nil {
+			d.Set("http_version", strconv.FormatFloat(*details.HTTPVersion, 'f', 1, 64))
+		} else {
+			d.Set("http_version", "")
+		}
+
+		expandObjectReadTagsWithImport(d, details.Tags, config.DefaultTags)
+
 		// OpenContrail workaround (https://github.com/vtdc/terraform-provider-openstack/issues/762)
 		if len(monitor.Pools) > 0 && monitor.Pools[0].ID != "" {
 			d.Set("pool_id", monitor.Pools[0].ID)
@@ -2,6 +2,7 @@ package vopencloud
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -97,6 +98,60 @@ func TestAccLBV2Monitor_octavia_udp(t *testing.T) {
 	})
 }
 
+func TestAccLBV2Monitor_octavia_http(t *testing.T) {
+	var monitor monitors.Monitor
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2MonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2MonitorConfigOctaviaHTTP("1.1", "example.com", "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2MonitorExists(t, "openstack_lb_monitor_v2.monitor_1", &monitor),
+					resource.TestCheckResourceAttr("openstack_lb_monitor_v2.monitor_1", "http_version", "1.1"),
+					resource.TestCheckResourceAttr("openstack_lb_monitor_v2.monitor_1", "domain_name", "example.com"),
+					resource.TestCheckResourceAttr("openstack_lb_monitor_v2.monitor_1", "tags.#", "1"),
+					resource.TestCheckResourceAttrSet("openstack_lb_monitor_v2.monitor_1", "operating_status"),
+				),
+			},
+			{
+				Config: testAccLbV2MonitorConfigOctaviaHTTP("1.0", "", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("openstack_lb_monitor_v2.monitor_1", "http_version", "1.0"),
+					resource.TestCheckResourceAttr("openstack_lb_monitor_v2.monitor_1", "domain_name", ""),
+					resource.TestCheckTypeSetElemAttr("openstack_lb_monitor_v2.monitor_1", "tags.*", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLBV2Monitor_octavia_invalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2MonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLbV2MonitorConfigOctaviaInvalid,
+				ExpectError: regexp.MustCompile("url_path can only be set for HTTP and HTTPS monitors"),
+			},
+		},
+	})
+}
+
 func testAccCheckLBV2MonitorDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	lbClient, err := chooseLBV2AccTestClient(config, osRegionName)
@@ -423,3 +478,107 @@ resource "openstack_lb_monitor_v2" "monitor_1" {
   }
 }
 `
+
+func testAccLbV2MonitorConfigOctaviaHTTP(httpVersion, domainName, tag string) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+
+  timeouts {
+    create = "15m"
+    update = "15m"
+    delete = "15m"
+  }
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  listener_id = "${openstack_lb_listener_v2.listener_1.id}"
+}
+
+resource "openstack_lb_monitor_v2" "monitor_1" {
+  name = "monitor_1"
+  type = "HTTP"
+  delay = 20
+  timeout = 10
+  max_retries = 5
+  url_path = "/health"
+  expected_codes = "200"
+  http_version = "%s"
+  domain_name = "%s" == "" ? null : "%s"
+  tags = ["%s"]
+  pool_id = "${openstack_lb_pool_v2.pool_1.id}"
+
+  timeouts {
+    create = "5m"
+    update = "5m"
+    delete = "5m"
+  }
+}
+`, httpVersion, domainName, domainName, tag)
+}
+
+const testAccLbV2MonitorConfigOctaviaInvalid = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "TCP"
+  protocol_port = 8080
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "TCP"
+  lb_method = "ROUND_ROBIN"
+  listener_id = "${openstack_lb_listener_v2.listener_1.id}"
+}
+
+resource "openstack_lb_monitor_v2" "monitor_1" {
+  name = "monitor_1"
+  type = "TCP"
+  delay = 20
+  timeout = 10
+  max_retries = 5
+  url_path = "/health"
+  pool_id = "${openstack_lb_pool_v2.pool_1.id}"
+}
+`
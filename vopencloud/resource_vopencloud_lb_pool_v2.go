@@ -120,6 +120,33 @@ func resourcePoolV2() *schema.Resource {
 				Default:  true,
 				Optional: true,
 			},
+
+			"tls_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"tls_container_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ca_tls_container_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tls_versions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"SSLv3", "TLSv1", "TLSv1.1", "TLSv1.2", "TLSv1.3",
+					}, false),
+				},
+			},
 		},
 	}
 }
@@ -156,7 +183,7 @@ func resourcePoolV2Create(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
-	createOpts := pools.CreateOpts{
+	opts := pools.CreateOpts{
 		TenantID:       d.Get("tenant_id").(string),
 		Name:           d.Get("name").(string),
 		Description:    d.Get("description").(string),
@@ -169,7 +196,33 @@ func resourcePoolV2Create(ctx context.Context, d *schema.ResourceData, meta inte
 
 	// Must omit if not set
 	if persistence != (pools.SessionPersistence{}) {
-		createOpts.Persistence = &persistence
+		opts.Persistence = &persistence
+	}
+
+	var createOpts pools.CreateOptsBuilder = opts
+	if config.UseOctavia {
+		args := lbV2ChangedAPIVersionArguments(d, lbV2PoolAPIVersions)
+		if err := checkLBV2APIVersion(lbClient, args, lbV2PoolAPIVersions); err != nil {
+			return diag.Errorf("Error creating pool: %s", err)
+		}
+
+		tlsOpts := PoolCreateOpts{
+			CreateOpts:        opts,
+			TLSEnabled:        d.Get("tls_enabled").(bool),
+			TLSContainerRef:   d.Get("tls_container_ref").(string),
+			CATLSContainerRef: d.Get("ca_tls_container_ref").(string),
+		}
+
+		if raw, ok := d.GetOk("tls_versions"); ok {
+			tlsOpts.TLSVersions = expandToStringSlice(raw.(*schema.Set).List())
+		}
+
+		createOpts = tlsOpts
+	} else {
+		args := lbV2ChangedAPIVersionArguments(d, lbV2PoolAPIVersions)
+		if err := checkLBV2OctaviaArguments(args); err != nil {
+			return diag.Errorf("Error creating pool: %s", err)
+		}
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -229,13 +282,26 @@ func resourcePoolV2Read(ctx context.Context, d *schema.ResourceData, meta interf
 		return diag.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	pool, err := pools.Get(lbClient, d.Id()).Extract()
+	r := pools.Get(lbClient, d.Id())
+	pool, err := r.Extract()
 	if err != nil {
 		return diag.FromErr(CheckDeleted(d, err, "pool"))
 	}
 
 	log.Printf("[DEBUG] Retrieved pool %s: %#v", d.Id(), pool)
 
+	if config.UseOctavia {
+		var tls lbV2PoolTLS
+		if err := r.ExtractIntoStructPtr(&tls, "pool"); err != nil {
+			return diag.Errorf("Unable to extract pool %s TLS settings: %s", d.Id(), err)
+		}
+
+		d.Set("tls_enabled", tls.TLSEnabled)
+		d.Set("tls_container_ref", tls.TLSContainerRef)
+		d.Set("ca_tls_container_ref", tls.CATLSContainerRef)
+		d.Set("tls_versions", tls.TLSVersions)
+	}
+
 	d.Set("lb_method", pool.LBMethod)
 	d.Set("protocol", pool.Protocol)
 	d.Set("description", pool.Description)
@@ -255,21 +321,54 @@ func resourcePoolV2Update(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	var updateOpts pools.UpdateOpts
+	var opts pools.UpdateOpts
 	if d.HasChange("lb_method") {
-		updateOpts.LBMethod = pools.LBMethod(d.Get("lb_method").(string))
+		opts.LBMethod = pools.LBMethod(d.Get("lb_method").(string))
 	}
 	if d.HasChange("name") {
 		name := d.Get("name").(string)
-		updateOpts.Name = &name
+		opts.Name = &name
 	}
 	if d.HasChange("description") {
 		description := d.Get("description").(string)
-		updateOpts.Description = &description
+		opts.Description = &description
 	}
 	if d.HasChange("admin_state_up") {
 		asu := d.Get("admin_state_up").(bool)
-		updateOpts.AdminStateUp = &asu
+		opts.AdminStateUp = &asu
+	}
+
+	var updateOpts pools.UpdateOptsBuilder = opts
+	if config.UseOctavia {
+		args := lbV2ChangedAPIVersionArguments(d, lbV2PoolAPIVersions)
+		if err := checkLBV2APIVersion(lbClient, args, lbV2PoolAPIVersions); err != nil {
+			return diag.Errorf("Unable to update pool %s: %s", d.Id(), err)
+		}
+
+		tlsOpts := PoolUpdateOpts{UpdateOpts: opts}
+		if d.HasChange("tls_enabled") {
+			tlsEnabled := d.Get("tls_enabled").(bool)
+			tlsOpts.TLSEnabled = &tlsEnabled
+		}
+		if d.HasChange("tls_container_ref") {
+			tlsContainerRef := d.Get("tls_container_ref").(string)
+			tlsOpts.TLSContainerRef = &tlsContainerRef
+		}
+		if d.HasChange("ca_tls_container_ref") {
+			caTLSContainerRef := d.Get("ca_tls_container_ref").(string)
+			tlsOpts.CATLSContainerRef = &caTLSContainerRef
+		}
+		if d.HasChange("tls_versions") {
+			tlsVersions := expandToStringSlice(d.Get("tls_versions").(*schema.Set).List())
+			tlsOpts.TLSVersions = &tlsVersions
+		}
+
+		updateOpts = tlsOpts
+	} else {
+		args := lbV2ChangedAPIVersionArguments(d, lbV2PoolAPIVersions)
+		if err := checkLBV2OctaviaArguments(args); err != nil {
+			return diag.Errorf("Unable to update pool %s: %s", d.Id(), err)
+		}
 	}
 
 	timeout := d.Timeout(schema.TimeoutUpdate)
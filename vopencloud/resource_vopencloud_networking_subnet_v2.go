@@ -231,6 +231,12 @@ func resourceNetworkingSubnetV2() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+
+			"default_tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 
 		CustomizeDiff: customdiff.Sequence(
@@ -238,6 +244,7 @@ func resourceNetworkingSubnetV2() *schema.Resource {
 			func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return networkingSubnetV2AllocationPoolsCustomizeDiff(diff)
 			},
+			customizeDiffObjectDefaultTags,
 		),
 	}
 }
@@ -336,7 +343,7 @@ func resourceNetworkingSubnetV2Create(ctx context.Context, d *schema.ResourceDat
 
 	d.SetId(s.ID)
 
-	tags := networkingV2AttributesTags(d)
+	tags := networkingV2CreateAttributesTags(d, config.DefaultTags)
 	if len(tags) > 0 {
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
 		tags, err := attributestags.ReplaceAll(networkingClient, "subnets", s.ID, tagOpts).Extract()
@@ -485,8 +492,8 @@ func resourceNetworkingSubnetV2Update(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
-	if d.HasChange("tags") {
-		tags := networkingV2UpdateAttributesTags(d)
+	if d.HasChanges("tags", "all_tags") {
+		tags := networkingV2UpdateAttributesTags(d, config.DefaultTags)
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
 		tags, err := attributestags.ReplaceAll(networkingClient, "subnets", d.Id(), tagOpts).Extract()
 		if err != nil {
@@ -22,6 +22,8 @@ func resourceOrchestrationStackV1() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customizeDiffObjectDefaultTags,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -75,6 +77,18 @@ func resourceOrchestrationStackV1() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"all_tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"default_tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			// Below are schemas for stack read
 			"capabilities": {
 				Type:     schema.TypeList,
@@ -190,12 +204,7 @@ func resourceOrchestrationStackV1Create(ctx context.Context, d *schema.ResourceD
 	if d.Get("parameters") != nil {
 		createOpts.Parameters = d.Get("parameters").(map[string]interface{})
 	}
-	if d.Get("tags") != nil {
-		t := d.Get("tags").([]interface{})
-		tags := make([]string, len(t))
-		for _, tag := range t {
-			tags = append(tags, tag.(string))
-		}
+	if tags := expandObjectCreateTags(d, config.DefaultTags); len(tags) > 0 {
 		createOpts.Tags = tags
 	}
 	if d.Get("timeout") != nil {
@@ -278,15 +287,13 @@ func resourceOrchestrationStackV1Read(ctx context.Context, d *schema.ResourceDat
 		d.Set("parameters", stack.Parameters)
 	}
 
-	if len(stack.Tags) > 0 {
-		var tags = []string{}
-		for _, v := range stack.Tags {
-			if v != "" {
-				tags = append(tags, v)
-			}
+	var tags = []string{}
+	for _, v := range stack.Tags {
+		if v != "" {
+			tags = append(tags, v)
 		}
-		d.Set("tags", tags)
 	}
+	expandObjectReadTagsWithImport(d, tags, config.DefaultTags)
 
 	if err := d.Set("creation_time", stack.CreationTime.Format(time.RFC3339)); err != nil {
 		log.Printf("[DEBUG] Unable to set openstack_orchestration_stack_v1 creation_time: %s", err)
@@ -328,14 +335,7 @@ func resourceOrchestrationStackV1Update(ctx context.Context, d *schema.ResourceD
 	if d.Get("timeout") != nil {
 		updateOpts.Timeout = d.Get("timeout").(int)
 	}
-	if d.Get("tags") != nil {
-		t := d.Get("tags").([]interface{})
-		tags := make([]string, len(t))
-		for _, tag := range t {
-			tags = append(tags, tag.(string))
-		}
-		updateOpts.Tags = tags
-	}
+	updateOpts.Tags = expandObjectUpdateTags(d, config.DefaultTags)
 
 	stack, err := stacks.Find(orchestrationClient, d.Id()).Extract()
 	if err != nil {
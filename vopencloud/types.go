@@ -1,8 +1,15 @@
 package vopencloud
 
 import (
+	"fmt"
+	"strconv"
+
+	octavialisteners "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/listeners"
+	octavialoadbalancers "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
+	octaviamonitors "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/monitors"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	neutronpools "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/subnetpools"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/vpnaas/endpointgroups"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/vpnaas/ikepolicies"
@@ -124,3 +131,229 @@ type SiteConnectionCreateOpts struct {
 	siteconnections.CreateOpts
 	ValueSpecs map[string]string `json:"value_specs,omitempty"`
 }
+
+// LoadBalancerAdditionalVip represents an additional VIP of an Octavia
+// load balancer.
+type LoadBalancerAdditionalVip struct {
+	SubnetID  string `json:"subnet_id" required:"true"`
+	IPAddress string `json:"ip_address,omitempty"`
+}
+
+// LoadBalancerCreateOpts represents the attributes used when creating a new
+// Octavia load balancer.
+type LoadBalancerCreateOpts struct {
+	octavialoadbalancers.CreateOpts
+	AdditionalVips []LoadBalancerAdditionalVip `json:"additional_vips,omitempty"`
+}
+
+// ToLoadBalancerCreateMap casts a CreateOpts struct to a map.
+// It overrides loadbalancers.ToLoadBalancerCreateMap to add the
+// additional_vips field.
+func (opts LoadBalancerCreateOpts) ToLoadBalancerCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "loadbalancer")
+}
+
+// LoadBalancerUpdateOpts represents the attributes used when updating an
+// existing Octavia load balancer.
+type LoadBalancerUpdateOpts struct {
+	octavialoadbalancers.UpdateOpts
+}
+
+// ToLoadBalancerUpdateMap casts an UpdateOpts struct to a map.
+// It overrides loadbalancers.ToLoadBalancerUpdateMap to unset the VIP QoS
+// policy with an empty ID.
+func (opts LoadBalancerUpdateOpts) ToLoadBalancerUpdateMap() (map[string]interface{}, error) {
+	b, err := BuildRequest(opts, "loadbalancer")
+	if err != nil {
+		return nil, err
+	}
+
+	m := b["loadbalancer"].(map[string]interface{})
+	if m["vip_qos_policy_id"] == "" {
+		m["vip_qos_policy_id"] = nil
+	}
+
+	return b, nil
+}
+
+// ListenerCreateOpts represents the attributes used when creating a new
+// Octavia listener.
+type ListenerCreateOpts struct {
+	octavialisteners.CreateOpts
+	TLSCiphers              string   `json:"tls_ciphers,omitempty"`
+	ALPNProtocols           []string `json:"alpn_protocols,omitempty"`
+	ClientAuthentication    string   `json:"client_authentication,omitempty"`
+	ClientCATLSContainerRef string   `json:"client_ca_tls_container_ref,omitempty"`
+	ClientCRLContainerRef   string   `json:"client_crl_container_ref,omitempty"`
+	HSTSMaxAge              *int     `json:"hsts_max_age,omitempty"`
+	HSTSIncludeSubdomains   bool     `json:"hsts_include_subdomains,omitempty"`
+	HSTSPreload             bool     `json:"hsts_preload,omitempty"`
+}
+
+// ToListenerCreateMap casts a CreateOpts struct to a map.
+// It overrides listeners.ToListenerCreateMap to add the TLS fields.
+func (opts ListenerCreateOpts) ToListenerCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "listener")
+}
+
+// ListenerUpdateOpts represents the attributes used when updating an existing
+// Octavia listener.
+type ListenerUpdateOpts struct {
+	octavialisteners.UpdateOpts
+	TLSCiphers              *string   `json:"tls_ciphers,omitempty"`
+	ALPNProtocols           *[]string `json:"alpn_protocols,omitempty"`
+	ClientAuthentication    *string   `json:"client_authentication,omitempty"`
+	ClientCATLSContainerRef *string   `json:"client_ca_tls_container_ref,omitempty"`
+	ClientCRLContainerRef   *string   `json:"client_crl_container_ref,omitempty"`
+	HSTSMaxAge              *int      `json:"hsts_max_age,omitempty"`
+	HSTSIncludeSubdomains   *bool     `json:"hsts_include_subdomains,omitempty"`
+	HSTSPreload             *bool     `json:"hsts_preload,omitempty"`
+}
+
+// ToListenerUpdateMap casts an UpdateOpts struct to a map.
+// It overrides listeners.ToListenerUpdateMap to add the TLS fields.
+func (opts ListenerUpdateOpts) ToListenerUpdateMap() (map[string]interface{}, error) {
+	b, err := BuildRequest(opts, "listener")
+	if err != nil {
+		return nil, err
+	}
+
+	m := b["listener"].(map[string]interface{})
+	if m["default_pool_id"] == "" {
+		m["default_pool_id"] = nil
+	}
+
+	// Empty references and zero max age unset the corresponding settings.
+	for _, k := range []string{"client_ca_tls_container_ref", "client_crl_container_ref"} {
+		if m[k] == "" {
+			m[k] = nil
+		}
+	}
+
+	if opts.HSTSMaxAge != nil && *opts.HSTSMaxAge == 0 {
+		m["hsts_max_age"] = nil
+	}
+
+	return b, nil
+}
+
+// PoolCreateOpts represents the attributes used when creating a new Octavia
+// pool.
+type PoolCreateOpts struct {
+	neutronpools.CreateOpts
+	TLSEnabled        bool     `json:"tls_enabled,omitempty"`
+	TLSContainerRef   string   `json:"tls_container_ref,omitempty"`
+	CATLSContainerRef string   `json:"ca_tls_container_ref,omitempty"`
+	TLSVersions       []string `json:"tls_versions,omitempty"`
+}
+
+// ToPoolCreateMap casts a CreateOpts struct to a map.
+// It overrides pools.ToPoolCreateMap to add the TLS fields.
+func (opts PoolCreateOpts) ToPoolCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "pool")
+}
+
+// PoolUpdateOpts represents the attributes used when updating an existing
+// Octavia pool.
+type PoolUpdateOpts struct {
+	neutronpools.UpdateOpts
+	TLSEnabled        *bool     `json:"tls_enabled,omitempty"`
+	TLSContainerRef   *string   `json:"tls_container_ref,omitempty"`
+	CATLSContainerRef *string   `json:"ca_tls_container_ref,omitempty"`
+	TLSVersions       *[]string `json:"tls_versions,omitempty"`
+}
+
+// ToPoolUpdateMap casts an UpdateOpts struct to a map.
+// It overrides pools.ToPoolUpdateMap to add the TLS fields.
+func (opts PoolUpdateOpts) ToPoolUpdateMap() (map[string]interface{}, error) {
+	b, err := BuildRequest(opts, "pool")
+	if err != nil {
+		return nil, err
+	}
+
+	m := b["pool"].(map[string]interface{})
+	for _, k := range []string{"tls_container_ref", "ca_tls_container_ref"} {
+		if m[k] == "" {
+			m[k] = nil
+		}
+	}
+
+	return b, nil
+}
+
+// MonitorCreateOpts represents the attributes used when creating a new
+// Octavia health monitor.
+type MonitorCreateOpts struct {
+	octaviamonitors.CreateOpts
+	HTTPVersion string   `json:"http_version,omitempty"`
+	DomainName  string   `json:"domain_name,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+// ToMonitorCreateMap casts a CreateOpts struct to a map.
+// It overrides monitors.ToMonitorCreateMap to add the http_version,
+// domain_name and tags fields.
+func (opts MonitorCreateOpts) ToMonitorCreateMap() (map[string]interface{}, error) {
+	b, err := BuildRequest(opts, "healthmonitor")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := expandLBV2MonitorHTTPVersion(b["healthmonitor"].(map[string]interface{})); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MonitorUpdateOpts represents the attributes used when updating an existing
+// Octavia health monitor.
+type MonitorUpdateOpts struct {
+	octaviamonitors.UpdateOpts
+	HTTPVersion *string   `json:"http_version,omitempty"`
+	DomainName  *string   `json:"domain_name,omitempty"`
+	Tags        *[]string `json:"tags,omitempty"`
+}
+
+// ToMonitorUpdateMap casts an UpdateOpts struct to a map.
+// It overrides monitors.ToMonitorUpdateMap to add the http_version,
+// domain_name and tags fields.
+func (opts MonitorUpdateOpts) ToMonitorUpdateMap() (map[string]interface{}, error) {
+	b, err := BuildRequest(opts, "healthmonitor")
+	if err != nil {
+		return nil, err
+	}
+
+	m := b["healthmonitor"].(map[string]interface{})
+	if err := expandLBV2MonitorHTTPVersion(m); err != nil {
+		return nil, err
+	}
+
+	if m["domain_name"] == "" {
+		m["domain_name"] = nil
+	}
+
+	return b, nil
+}
+
+// expandLBV2MonitorHTTPVersion converts the http_version string into the
+// number expected by Octavia. An empty version unsets the HTTP version.
+func expandLBV2MonitorHTTPVersion(m map[string]interface{}) error {
+	v, ok := m["http_version"].(string)
+	if !ok {
+		return nil
+	}
+
+	if v == "" {
+		m["http_version"] = nil
+		return nil
+	}
+
+	version, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return fmt.Errorf("Invalid http_version %q: %s", v, err)
+	}
+	m["http_version"] = version
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package vopencloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -128,25 +129,62 @@ func expandObjectReadTags(d *schema.ResourceData, tags []string) {
 	d.Set("all_tags", tags)
 
 	allTags := d.Get("all_tags").(*schema.Set)
-	desiredTags := d.Get("tags").(*schema.Set)
+	desiredTags := expandObjectTagsSet(d.Get("tags"))
 	actualTags := allTags.Intersection(desiredTags)
 	if !actualTags.Equal(desiredTags) {
 		d.Set("tags", expandToStringSlice(actualTags.List()))
 	}
 }
 
-func expandObjectUpdateTags(d *schema.ResourceData) []string {
+// expandObjectReadTagsWithImport works like expandObjectReadTags, but sets
+// tags from the API, when all_tags isn't known yet, e.g. after an import.
+// The provider-level default tags are left out, unless they are configured.
+func expandObjectReadTagsWithImport(d *schema.ResourceData, tags []string, defaultTags []string) {
+	if d.Get("all_tags").(*schema.Set).Len() == 0 {
+		d.Set("tags", objectTagsWithoutDefaults(tags, expandObjectTags(d), defaultTags))
+	}
+
+	expandObjectReadTags(d, tags)
+}
+
+// objectTagsWithoutDefaults returns the tags without the provider-level
+// default tags, which aren't configured.
+func objectTagsWithoutDefaults(tags, configuredTags, defaultTags []string) []string {
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if strSliceContains(defaultTags, tag) && !strSliceContains(configuredTags, tag) {
+			continue
+		}
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+func expandObjectUpdateTags(d *schema.ResourceData, defaultTags []string) []string {
 	allTags := d.Get("all_tags").(*schema.Set)
 	oldTagsRaw, newTagsRaw := d.GetChange("tags")
-	oldTags, newTags := oldTagsRaw.(*schema.Set), newTagsRaw.(*schema.Set)
+	oldTags, newTags := expandObjectTagsSet(oldTagsRaw), expandObjectTagsSet(newTagsRaw)
+	oldDefaultTags, _ := d.GetChange("default_tags")
 
-	allTagsWithoutOld := allTags.Difference(oldTags)
+	return expandToStringSlice(mergeObjectTags(allTags, oldTags, newTags, oldDefaultTags.(*schema.Set), defaultTags).List())
+}
+
+// expandObjectTagsSet returns the tags as a set, regardless of whether the
+// resource declares them as a set or as a list.
+func expandObjectTagsSet(v interface{}) *schema.Set {
+	switch v := v.(type) {
+	case *schema.Set:
+		return v
+	case []interface{}:
+		return schema.NewSet(schema.HashString, v)
+	}
 
-	return expandToStringSlice(allTagsWithoutOld.Union(newTags).List())
+	return schema.NewSet(schema.HashString, nil)
 }
 
 func expandObjectTags(d *schema.ResourceData) []string {
-	rawTags := d.Get("tags").(*schema.Set).List()
+	rawTags := expandObjectTagsSet(d.Get("tags")).List()
 	tags := make([]string, len(rawTags))
 
 	for i, raw := range rawTags {
@@ -156,6 +194,81 @@ func expandObjectTags(d *schema.ResourceData) []string {
 	return tags
 }
 
+// expandObjectCreateTags returns the tags of a resource merged with the
+// provider-level default tags.
+func expandObjectCreateTags(d *schema.ResourceData, defaultTags []string) []string {
+	tags := expandObjectTags(d)
+	if len(defaultTags) == 0 {
+		return tags
+	}
+
+	return sliceUnion(tags, defaultTags)
+}
+
+// mergeObjectTags replaces the old tags of a resource with the new ones,
+// keeps the tags which were set outside of Terraform and replaces the
+// previously applied provider-level default tags with the current ones.
+func mergeObjectTags(allTags, oldTags, newTags, oldDefaultTags *schema.Set, defaultTags []string) *schema.Set {
+	merged := allTags.Difference(oldTags).Difference(oldDefaultTags).Union(newTags)
+	for _, tag := range defaultTags {
+		merged.Add(tag)
+	}
+
+	return merged
+}
+
+// customizeDiffObjectDefaultTags plans all_tags with the provider-level
+// default tags merged in and records the applied default tags in
+// default_tags. A resource, which misses any of the default tags or still has
+// a removed default tag, gets an update, while tags itself never contains the
+// default tags and doesn't cause a perpetual diff.
+func customizeDiffObjectDefaultTags(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config, ok := meta.(*Config)
+	if !ok {
+		return nil
+	}
+
+	oldDefaultTags := diff.Get("default_tags").(*schema.Set)
+	if oldDefaultTags.Len() == 0 && len(config.DefaultTags) == 0 {
+		return nil
+	}
+
+	defaultTags := schema.NewSet(schema.HashString, expandToInterfaceSlice(config.DefaultTags))
+	if !defaultTags.Equal(oldDefaultTags) {
+		if err := diff.SetNew("default_tags", defaultTags.List()); err != nil {
+			return err
+		}
+	}
+
+	if diff.Id() == "" {
+		return nil
+	}
+
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed("all_tags")
+	}
+
+	allTags := diff.Get("all_tags").(*schema.Set)
+	oldTagsRaw, newTagsRaw := diff.GetChange("tags")
+	oldTags, newTags := expandObjectTagsSet(oldTagsRaw), expandObjectTagsSet(newTagsRaw)
+
+	merged := mergeObjectTags(allTags, oldTags, newTags, oldDefaultTags, config.DefaultTags)
+	if merged.Equal(allTags) {
+		return nil
+	}
+
+	return diff.SetNew("all_tags", merged.List())
+}
+
+func expandToInterfaceSlice(v []string) []interface{} {
+	s := make([]interface{}, len(v))
+	for i, val := range v {
+		s[i] = val
+	}
+
+	return s
+}
+
 func expandToMapStringString(v map[string]interface{}) map[string]string {
 	m := make(map[string]string)
 	for key, val := range v {
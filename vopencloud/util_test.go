@@ -3,6 +3,7 @@ package vopencloud
 import (
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -81,3 +82,90 @@ func TestUnitMapDiffWithNilValues(t *testing.T) {
 	assert.Equal(t, result["c"], "3")
 	assert.Equal(t, len(result), 3)
 }
+
+func TestUnitMergeObjectTags(t *testing.T) {
+	allTags := schema.NewSet(schema.HashString, []interface{}{"foo", "bar", "external", "owner=platform", "team=db"})
+	oldTags := schema.NewSet(schema.HashString, []interface{}{"foo", "bar"})
+	newTags := schema.NewSet(schema.HashString, []interface{}{"foo", "baz"})
+	oldDefaultTags := schema.NewSet(schema.HashString, []interface{}{"owner=platform", "team=db"})
+	defaultTags := []string{"owner=platform", "cost-center=1234"}
+
+	expected := schema.NewSet(schema.HashString, []interface{}{"foo", "baz", "external", "owner=platform", "cost-center=1234"})
+
+	actual := mergeObjectTags(allTags, oldTags, newTags, oldDefaultTags, defaultTags)
+	assert.True(t, expected.Equal(actual))
+
+	// A removed default tag is kept, when it's configured in tags.
+	newTags = schema.NewSet(schema.HashString, []interface{}{"foo", "team=db"})
+
+	expected = schema.NewSet(schema.HashString, []interface{}{"foo", "team=db", "external", "owner=platform", "cost-center=1234"})
+
+	actual = mergeObjectTags(allTags, oldTags, newTags, oldDefaultTags, defaultTags)
+	assert.True(t, expected.Equal(actual))
+}
+
+func TestUnitObjectTagsWithoutDefaults(t *testing.T) {
+	tags := []string{"foo", "owner=platform", "cost-center=1234"}
+	defaultTags := []string{"owner=platform", "cost-center=1234"}
+
+	assert.Equal(t, []string{"foo"}, objectTagsWithoutDefaults(tags, nil, defaultTags))
+	assert.Equal(t, []string{"foo", "owner=platform"}, objectTagsWithoutDefaults(tags, []string{"owner=platform"}, defaultTags))
+	assert.Equal(t, tags, objectTagsWithoutDefaults(tags, nil, nil))
+}
+
+func TestUnitExpandObjectReadTagsWithImport(t *testing.T) {
+	tagsSchema := map[string]*schema.Schema{
+		"tags": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"all_tags": {
+			Type:     schema.TypeSet,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+	defaultTags := []string{"owner=platform"}
+
+	// Import: tags are set from the API without the default tags.
+	d := schema.TestResourceDataRaw(t, tagsSchema, map[string]interface{}{})
+	expandObjectReadTagsWithImport(d, []string{"foo", "owner=platform"}, defaultTags)
+
+	assert.ElementsMatch(t, []interface{}{"foo"}, d.Get("tags").(*schema.Set).List())
+	assert.ElementsMatch(t, []interface{}{"foo", "owner=platform"}, d.Get("all_tags").(*schema.Set).List())
+
+	// Refresh: tags, which were added outside of Terraform, stay in all_tags.
+	expandObjectReadTagsWithImport(d, []string{"foo", "owner=platform", "external"}, defaultTags)
+
+	assert.ElementsMatch(t, []interface{}{"foo"}, d.Get("tags").(*schema.Set).List())
+	assert.ElementsMatch(t, []interface{}{"foo", "owner=platform", "external"}, d.Get("all_tags").(*schema.Set).List())
+}
+
+func TestUnitExpandObjectCreateTags(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"tags": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}, map[string]interface{}{
+		"tags": []interface{}{"foo", "owner=platform"},
+	})
+
+	expected := []string{"cost-center=1234", "foo", "owner=platform"}
+
+	actual := expandObjectCreateTags(d, []string{"owner=platform", "cost-center=1234"})
+	assert.ElementsMatch(t, expected, actual)
+
+	actual = expandObjectCreateTags(d, nil)
+	assert.ElementsMatch(t, []string{"foo", "owner=platform"}, actual)
+}
+
+func TestUnitExpandObjectTagsSet(t *testing.T) {
+	expected := schema.NewSet(schema.HashString, []interface{}{"foo", "bar"})
+
+	assert.True(t, expected.Equal(expandObjectTagsSet([]interface{}{"foo", "bar"})))
+	assert.True(t, expected.Equal(expandObjectTagsSet(expected)))
+	assert.Equal(t, 0, expandObjectTagsSet(nil).Len())
+}